@@ -0,0 +1,76 @@
+package budget
+
+import "github.com/scalepad/terraform-provider-litellm/internal/utils"
+
+// BudgetConfig represents a per-model budget entry in model_max_budget
+type BudgetConfig struct {
+	MaxBudget      *float64 `json:"max_budget,omitempty"`
+	BudgetDuration *string  `json:"budget_duration,omitempty"`
+	TPMLimit       *int     `json:"tpm_limit,omitempty"`
+	RPMLimit       *int     `json:"rpm_limit,omitempty"`
+}
+
+// BudgetLimits are the limits of a budget. They are sent to /budget/new and /budget/update, and inline in the
+// create requests of customers, organizations and tags, which get a budget of their own.
+// Limits removed from the configuration are sent as null so /budget/update clears them.
+type BudgetLimits struct {
+	MaxBudget           utils.Nullable[float64]                 `json:"max_budget,omitzero"`
+	SoftBudget          utils.Nullable[float64]                 `json:"soft_budget,omitzero"`
+	MaxParallelRequests utils.Nullable[int]                     `json:"max_parallel_requests,omitzero"`
+	TPMLimit            utils.Nullable[int]                     `json:"tpm_limit,omitzero"`
+	RPMLimit            utils.Nullable[int]                     `json:"rpm_limit,omitzero"`
+	BudgetDuration      utils.Nullable[string]                  `json:"budget_duration,omitzero"`
+	ModelMaxBudget      utils.Nullable[map[string]BudgetConfig] `json:"model_max_budget,omitzero"`
+}
+
+// BudgetRequest represents the request body for /budget/new and /budget/update
+type BudgetRequest struct {
+	BudgetID string `json:"budget_id"`
+	BudgetLimits
+}
+
+// LitellmBudgetTable represents a budget as returned by the API, either on its own or
+// attached to a customer, organization, organization member or tag
+type LitellmBudgetTable struct {
+	BudgetID            string                  `json:"budget_id"`
+	MaxBudget           *float64                `json:"max_budget"`
+	SoftBudget          *float64                `json:"soft_budget"`
+	MaxParallelRequests *int                    `json:"max_parallel_requests"`
+	TPMLimit            *int                    `json:"tpm_limit"`
+	RPMLimit            *int                    `json:"rpm_limit"`
+	BudgetDuration      *string                 `json:"budget_duration"`
+	BudgetResetAt       string                  `json:"budget_reset_at"`
+	ModelMaxBudget      map[string]BudgetConfig `json:"model_max_budget"`
+}
+
+// BudgetInfoRequest represents the request body for /budget/info
+type BudgetInfoRequest struct {
+	Budgets []string `json:"budgets"`
+}
+
+// BudgetDeleteRequest represents the request body for /budget/delete
+type BudgetDeleteRequest struct {
+	ID string `json:"id"`
+}
+
+// BudgetResponse represents a budget as returned by the /budget endpoints
+type BudgetResponse struct {
+	LitellmBudgetTable
+	CreatedBy string `json:"created_by"`
+	UpdatedBy string `json:"updated_by"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ProviderBudgetsResponse represents the response of GET /provider/budgets
+type ProviderBudgetsResponse struct {
+	Providers map[string]ProviderBudget `json:"providers"`
+}
+
+// ProviderBudget represents the budget window of an upstream provider
+type ProviderBudget struct {
+	BudgetLimit   *float64 `json:"budget_limit"`
+	TimePeriod    *string  `json:"time_period"`
+	Spend         *float64 `json:"spend"`
+	BudgetResetAt *string  `json:"budget_reset_at"`
+}
@@ -0,0 +1,92 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// createBudget creates a new budget using the typed request/response pattern
+func createBudget(ctx context.Context, c *litellm.Client, request *BudgetRequest) (*BudgetResponse, error) {
+	response, err := litellm.SendRequestTyped[BudgetRequest, BudgetResponse](
+		ctx, c, http.MethodPost, "/budget/new", request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create budget: %w", err)
+	}
+
+	return response, nil
+}
+
+// getBudget retrieves a budget by budget ID.
+// It returns nil without an error when the budget does not exist.
+func getBudget(ctx context.Context, c *litellm.Client, budgetID string) (*BudgetResponse, error) {
+	infoRequest := &BudgetInfoRequest{
+		Budgets: []string{budgetID},
+	}
+
+	response, err := litellm.SendRequestTyped[BudgetInfoRequest, []BudgetResponse](
+		ctx, c, http.MethodPost, "/budget/info", infoRequest,
+	)
+	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get budget: %w", err)
+	}
+
+	for _, budget := range *response {
+		if budget.BudgetID == budgetID {
+			return &budget, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// updateBudget updates an existing budget using the typed request pattern
+func updateBudget(ctx context.Context, c *litellm.Client, request *BudgetRequest) (*BudgetResponse, error) {
+	response, err := litellm.SendRequestTyped[BudgetRequest, BudgetResponse](
+		ctx, c, http.MethodPost, "/budget/update", request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	return response, nil
+}
+
+// deleteBudget deletes a budget by budget ID
+func deleteBudget(ctx context.Context, c *litellm.Client, budgetID string) error {
+	deleteRequest := &BudgetDeleteRequest{
+		ID: budgetID,
+	}
+
+	_, err := litellm.SendRequestTyped[BudgetDeleteRequest, BudgetResponse](
+		ctx, c, http.MethodPost, "/budget/delete", deleteRequest,
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	return nil
+}
+
+// listProviderBudgets retrieves the budget windows configured for upstream providers
+func listProviderBudgets(ctx context.Context, c *litellm.Client) (map[string]ProviderBudget, error) {
+	response, err := litellm.SendRequestTyped[interface{}, ProviderBudgetsResponse](
+		ctx, c, http.MethodGet, "/provider/budgets", nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list provider budgets: %w", err)
+	}
+
+	return response.Providers, nil
+}
@@ -0,0 +1,276 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// LimitFields lists the schema fields of the budget limits, see ExpandBudgetLimits
+var LimitFields = []string{
+	"max_budget",
+	"soft_budget",
+	"max_parallel_requests",
+	"tpm_limit",
+	"rpm_limit",
+	"budget_duration",
+	"model_max_budget",
+}
+
+// buildBudgetRequest builds a BudgetRequest from Terraform resource data.
+// The same payload is used for /budget/new and /budget/update.
+func buildBudgetRequest(d *schema.ResourceData, budgetID string) *BudgetRequest {
+	return &BudgetRequest{
+		BudgetID:     budgetID,
+		BudgetLimits: ExpandBudgetLimits(d),
+	}
+}
+
+// ExpandBudgetLimits builds BudgetLimits from the limit fields of a resource.
+// Limits removed from the configuration are sent as null, as /budget/update only changes the fields present
+// in the request.
+func ExpandBudgetLimits(d *schema.ResourceData) BudgetLimits {
+	return BudgetLimits{
+		MaxBudget:           utils.GetNullable[float64](d, "max_budget"),
+		SoftBudget:          utils.GetNullable[float64](d, "soft_budget"),
+		MaxParallelRequests: utils.GetNullable[int](d, "max_parallel_requests"),
+		TPMLimit:            utils.GetNullable[int](d, "tpm_limit"),
+		RPMLimit:            utils.GetNullable[int](d, "rpm_limit"),
+		BudgetDuration:      utils.GetNullable[string](d, "budget_duration"),
+		ModelMaxBudget:      getModelMaxBudget(d),
+	}
+}
+
+// getModelMaxBudget reads model_max_budget for a request, see utils.GetNullable
+func getModelMaxBudget(d *schema.ResourceData) utils.Nullable[map[string]BudgetConfig] {
+	if v, ok := d.GetOk("model_max_budget"); ok {
+		return utils.NullableValue(expandModelMaxBudget(v.(*schema.Set)))
+	}
+	if d.HasChange("model_max_budget") {
+		return utils.NullValue[map[string]BudgetConfig]()
+	}
+	return utils.Nullable[map[string]BudgetConfig]{}
+}
+
+// SetBudgetLimits sets the limit fields of a resource from a budget it owns.
+// Limits the API does not return are cleared, so limits removed outside Terraform show up as drift.
+func SetBudgetLimits(d *schema.ResourceData, budget *LitellmBudgetTable) error {
+	if budget == nil {
+		return nil
+	}
+
+	fields := make(map[string]interface{}, len(LimitFields))
+	for _, field := range LimitFields {
+		fields[field] = nil
+	}
+	if budget.MaxBudget != nil {
+		fields["max_budget"] = *budget.MaxBudget
+	}
+	if budget.SoftBudget != nil {
+		fields["soft_budget"] = *budget.SoftBudget
+	}
+	if budget.MaxParallelRequests != nil {
+		fields["max_parallel_requests"] = *budget.MaxParallelRequests
+	}
+	if budget.TPMLimit != nil {
+		fields["tpm_limit"] = *budget.TPMLimit
+	}
+	if budget.RPMLimit != nil {
+		fields["rpm_limit"] = *budget.RPMLimit
+	}
+	if budget.BudgetDuration != nil {
+		fields["budget_duration"] = *budget.BudgetDuration
+	}
+	if budget.ModelMaxBudget != nil {
+		fields["model_max_budget"] = flattenModelMaxBudget(budget.ModelMaxBudget)
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+// UsesSharedBudget reports whether the budget_id of a customer or organization refers to a budget managed
+// outside the resource, such as a litellm_budget. The inline limits are then neither read from the budget
+// nor written to it, as that would change the budget for everything else attached to it.
+// Without configuration, during refresh, a budget is considered shared when no inline limit is tracked in state.
+func UsesSharedBudget(d *schema.ResourceData) bool {
+	if config := d.GetRawConfig(); !config.IsNull() {
+		return !config.GetAttr("budget_id").IsNull()
+	}
+
+	if d.Get("budget_id").(string) == "" {
+		return false
+	}
+	for _, field := range LimitFields {
+		if _, ok := d.GetOk(field); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ReplaceOnSharedBudgetRemoval is a CustomizeDiff function that replaces a customer or organization when its
+// configuration moves from a shared budget_id to inline limits. The shared budget must not be changed and
+// the update endpoints cannot attach a new budget, so the resource is recreated with a budget of its own.
+func ReplaceOnSharedBudgetRemoval(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldBudgetID, _ := d.GetChange("budget_id")
+	if oldBudgetID.(string) == "" {
+		return nil
+	}
+
+	// A resource that tracked inline limits owns its budget
+	for _, field := range LimitFields {
+		old, _ := d.GetChange(field)
+		if !isEmptyLimit(old) {
+			return nil
+		}
+	}
+
+	for _, field := range LimitFields {
+		if d.HasChange(field) {
+			return d.ForceNew(field)
+		}
+	}
+
+	return nil
+}
+
+// isEmptyLimit reports whether a limit field value is unset
+func isEmptyLimit(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case float64:
+		return v == 0
+	case int:
+		return v == 0
+	case string:
+		return v == ""
+	case *schema.Set:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
+
+// setBudgetResourceData sets Terraform resource data from a BudgetResponse
+func setBudgetResourceData(d *schema.ResourceData, budget *BudgetResponse) error {
+	fields := map[string]interface{}{
+		"budget_id":       budget.BudgetID,
+		"budget_reset_at": budget.BudgetResetAt,
+		"created_by":      budget.CreatedBy,
+		"updated_by":      budget.UpdatedBy,
+		"created_at":      budget.CreatedAt,
+		"updated_at":      budget.UpdatedAt,
+	}
+
+	for field, value := range fields {
+		// Use SetIfNotZero to preserve existing values when API doesn't return them
+		utils.SetIfNotZero(d, field, value)
+	}
+
+	return SetBudgetLimits(d, &budget.LitellmBudgetTable)
+}
+
+// expandModelMaxBudget converts the model_max_budget set into the API representation
+func expandModelMaxBudget(set *schema.Set) map[string]BudgetConfig {
+	if set == nil || set.Len() == 0 {
+		return nil
+	}
+
+	result := make(map[string]BudgetConfig, set.Len())
+	for _, item := range set.List() {
+		m := item.(map[string]interface{})
+		model, _ := m["model"].(string)
+		if model == "" {
+			continue
+		}
+
+		config := BudgetConfig{}
+		if v, ok := m["max_budget"].(float64); ok {
+			config.MaxBudget = utils.FloatPtr(v)
+		}
+		if v, ok := m["budget_duration"].(string); ok {
+			config.BudgetDuration = utils.StringPtr(v)
+		}
+		if v, ok := m["tpm_limit"].(int); ok {
+			config.TPMLimit = utils.IntPtr(v)
+		}
+		if v, ok := m["rpm_limit"].(int); ok {
+			config.RPMLimit = utils.IntPtr(v)
+		}
+
+		result[model] = config
+	}
+
+	return result
+}
+
+// flattenModelMaxBudget converts the API model_max_budget map into the Terraform set representation
+func flattenModelMaxBudget(budgets map[string]BudgetConfig) []interface{} {
+	result := make([]interface{}, 0, len(budgets))
+	for model, config := range budgets {
+		item := map[string]interface{}{
+			"model": model,
+		}
+		if config.MaxBudget != nil {
+			item["max_budget"] = *config.MaxBudget
+		}
+		if config.BudgetDuration != nil {
+			item["budget_duration"] = *config.BudgetDuration
+		}
+		if config.TPMLimit != nil {
+			item["tpm_limit"] = *config.TPMLimit
+		}
+		if config.RPMLimit != nil {
+			item["rpm_limit"] = *config.RPMLimit
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// flattenProviderBudgets converts provider budgets into the data source's budgets list, sorted by provider
+func flattenProviderBudgets(budgets map[string]ProviderBudget) []interface{} {
+	providers := make([]string, 0, len(budgets))
+	for provider := range budgets {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+
+	result := make([]interface{}, 0, len(providers))
+	for _, provider := range providers {
+		budget := budgets[provider]
+
+		item := map[string]interface{}{
+			"provider": provider,
+		}
+		if budget.BudgetLimit != nil {
+			item["budget_limit"] = *budget.BudgetLimit
+		}
+		if budget.TimePeriod != nil {
+			item["time_period"] = *budget.TimePeriod
+		}
+		if budget.Spend != nil {
+			item["spend"] = *budget.Spend
+		}
+		if budget.BudgetResetAt != nil {
+			item["reset_at"] = *budget.BudgetResetAt
+		}
+
+		result = append(result, item)
+	}
+	return result
+}
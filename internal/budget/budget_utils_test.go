@@ -0,0 +1,256 @@
+package budget
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils/testutil"
+)
+
+func TestBuildBudgetRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]interface{}
+		budgetID string
+		expected *BudgetRequest
+	}{
+		{
+			name: "complete budget data",
+			input: map[string]interface{}{
+				"max_budget":            500.0,
+				"soft_budget":           400.0,
+				"max_parallel_requests": 10,
+				"tpm_limit":             100000,
+				"rpm_limit":             1000,
+				"budget_duration":       "30d",
+				"model_max_budget": []interface{}{
+					map[string]interface{}{
+						"model":      "gpt-4o",
+						"max_budget": 100.0,
+						"tpm_limit":  5000,
+					},
+				},
+			},
+			budgetID: "budget-123",
+			expected: &BudgetRequest{
+				BudgetID: "budget-123",
+				BudgetLimits: BudgetLimits{
+					MaxBudget:           utils.NullableValue(500.0),
+					SoftBudget:          utils.NullableValue(400.0),
+					MaxParallelRequests: utils.NullableValue(10),
+					TPMLimit:            utils.NullableValue(100000),
+					RPMLimit:            utils.NullableValue(1000),
+					BudgetDuration:      utils.NullableValue("30d"),
+					ModelMaxBudget: utils.NullableValue(map[string]BudgetConfig{
+						"gpt-4o": {
+							MaxBudget: float64Ptr(100.0),
+							TPMLimit:  intPtr(5000),
+						},
+					}),
+				},
+			},
+		},
+		{
+			name:     "id only",
+			input:    map[string]interface{}{},
+			budgetID: "budget-minimal",
+			expected: &BudgetRequest{
+				BudgetID: "budget-minimal",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := ResourceBudget()
+			d := schema.TestResourceDataRaw(t, resource.Schema, tt.input)
+
+			result := buildBudgetRequest(d, tt.budgetID)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildBudgetRequest() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildBudgetRequestClearsRemovedLimits(t *testing.T) {
+	state := map[string]interface{}{
+		"budget_id":       "budget-123",
+		"max_budget":      500.0,
+		"soft_budget":     400.0,
+		"tpm_limit":       100000,
+		"budget_duration": "30d",
+		"model_max_budget": []interface{}{
+			map[string]interface{}{"model": "gpt-4o", "max_budget": 100.0},
+		},
+	}
+	config := map[string]interface{}{
+		"budget_id":  "budget-123",
+		"max_budget": 600.0,
+	}
+
+	d := testutil.ResourceDataWithState(t, ResourceBudget(), state, config)
+	result := buildBudgetRequest(d, "budget-123")
+
+	expected := &BudgetRequest{
+		BudgetID: "budget-123",
+		BudgetLimits: BudgetLimits{
+			MaxBudget:      utils.NullableValue(600.0),
+			SoftBudget:     utils.NullValue[float64](),
+			TPMLimit:       utils.NullValue[int](),
+			BudgetDuration: utils.NullValue[string](),
+			ModelMaxBudget: utils.NullValue[map[string]BudgetConfig](),
+		},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("buildBudgetRequest() = %+v, want %+v", result, expected)
+	}
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	expectedBody := `{"budget_id":"budget-123","max_budget":600,"soft_budget":null,"tpm_limit":null,"budget_duration":null,"model_max_budget":null}`
+	if string(body) != expectedBody {
+		t.Errorf("request body = %s, want %s", body, expectedBody)
+	}
+}
+
+func TestSetBudgetResourceData(t *testing.T) {
+	tests := []struct {
+		name       string
+		budgetResp *BudgetResponse
+	}{
+		{
+			name: "complete budget data",
+			budgetResp: &BudgetResponse{
+				LitellmBudgetTable: LitellmBudgetTable{
+					BudgetID:       "budget-123",
+					MaxBudget:      float64Ptr(500.0),
+					SoftBudget:     float64Ptr(400.0),
+					TPMLimit:       intPtr(1000),
+					BudgetDuration: stringPtr("30d"),
+					BudgetResetAt:  "2025-01-01T00:00:00Z",
+					ModelMaxBudget: map[string]BudgetConfig{
+						"gpt-4o":            {MaxBudget: float64Ptr(100.0)},
+						"claude-3-5-sonnet": {MaxBudget: float64Ptr(50.0)},
+					},
+				},
+			},
+		},
+		{
+			name: "minimal budget data",
+			budgetResp: &BudgetResponse{
+				LitellmBudgetTable: LitellmBudgetTable{
+					BudgetID: "budget-minimal",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := ResourceBudget()
+			d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+
+			if err := setBudgetResourceData(d, tt.budgetResp); err != nil {
+				t.Fatalf("setBudgetResourceData() unexpected error: %v", err)
+			}
+
+			if d.Get("budget_id") != tt.budgetResp.BudgetID {
+				t.Errorf("Expected budget_id %s, got %v", tt.budgetResp.BudgetID, d.Get("budget_id"))
+			}
+			if tt.budgetResp.MaxBudget != nil && d.Get("max_budget") != *tt.budgetResp.MaxBudget {
+				t.Errorf("Expected max_budget %f, got %v", *tt.budgetResp.MaxBudget, d.Get("max_budget"))
+			}
+			if tt.budgetResp.BudgetDuration != nil && d.Get("budget_duration") != *tt.budgetResp.BudgetDuration {
+				t.Errorf("Expected budget_duration %s, got %v", *tt.budgetResp.BudgetDuration, d.Get("budget_duration"))
+			}
+			if d.Get("model_max_budget").(*schema.Set).Len() != len(tt.budgetResp.ModelMaxBudget) {
+				t.Errorf("Expected %d model_max_budget entries, got %d", len(tt.budgetResp.ModelMaxBudget), d.Get("model_max_budget").(*schema.Set).Len())
+			}
+		})
+	}
+}
+
+func TestSetBudgetLimitsClearsLimitsRemovedOutsideTerraform(t *testing.T) {
+	resource := ResourceBudget()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"max_budget":      500.0,
+		"soft_budget":     400.0,
+		"tpm_limit":       1000,
+		"budget_duration": "30d",
+		"model_max_budget": []interface{}{
+			map[string]interface{}{"model": "gpt-4o", "max_budget": 100.0},
+		},
+	})
+
+	table := &LitellmBudgetTable{
+		BudgetID:  "budget-123",
+		MaxBudget: float64Ptr(600.0),
+	}
+
+	if err := SetBudgetLimits(d, table); err != nil {
+		t.Fatalf("SetBudgetLimits() unexpected error: %v", err)
+	}
+
+	if d.Get("max_budget") != 600.0 {
+		t.Errorf("Expected max_budget 600, got %v", d.Get("max_budget"))
+	}
+	for _, field := range []string{"soft_budget", "tpm_limit", "budget_duration", "model_max_budget"} {
+		if v, ok := d.GetOk(field); ok {
+			t.Errorf("Expected %s to be cleared, got %v", field, v)
+		}
+	}
+}
+
+func TestFlattenProviderBudgets(t *testing.T) {
+	budgets := map[string]ProviderBudget{
+		"openai": {
+			BudgetLimit:   float64Ptr(100.0),
+			TimePeriod:    stringPtr("1d"),
+			Spend:         float64Ptr(12.5),
+			BudgetResetAt: stringPtr("2025-01-02T00:00:00Z"),
+		},
+		"anthropic": {
+			BudgetLimit: float64Ptr(50.0),
+			TimePeriod:  stringPtr("30d"),
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"provider":     "anthropic",
+			"budget_limit": 50.0,
+			"time_period":  "30d",
+		},
+		map[string]interface{}{
+			"provider":     "openai",
+			"budget_limit": 100.0,
+			"time_period":  "1d",
+			"spend":        12.5,
+			"reset_at":     "2025-01-02T00:00:00Z",
+		},
+	}
+
+	if result := flattenProviderBudgets(budgets); !reflect.DeepEqual(result, expected) {
+		t.Errorf("flattenProviderBudgets() = %v, want %v", result, expected)
+	}
+}
+
+// Helper functions for creating pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,34 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+func DataSourceLiteLLMProviderBudgets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLiteLLMProviderBudgetsRead,
+		Schema:      dataSourceProviderBudgetsSchema(),
+	}
+}
+
+func dataSourceLiteLLMProviderBudgetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*litellm.Client)
+
+	budgets, err := listProviderBudgets(ctx, c)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read provider budgets: %w", err))
+	}
+
+	d.SetId("provider_budgets")
+
+	if err := d.Set("budgets", flattenProviderBudgets(budgets)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
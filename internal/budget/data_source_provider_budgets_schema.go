@@ -0,0 +1,44 @@
+package budget
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceProviderBudgetsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"budgets": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Budget windows of the upstream providers, sorted by provider",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"provider": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the upstream provider, e.g. openai",
+					},
+					"budget_limit": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "Maximum spend allowed for the provider in each time period",
+					},
+					"time_period": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Length of the budget window, e.g. 1d",
+					},
+					"spend": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "Spend in the current budget window",
+					},
+					"reset_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Time at which the current budget window resets",
+					},
+				},
+			},
+		},
+	}
+}
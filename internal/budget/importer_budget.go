@@ -0,0 +1,12 @@
+package budget
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BudgetImporter provides import functionality for LiteLLM budget resources
+func BudgetImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
@@ -0,0 +1,111 @@
+package budget
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceBudget defines the schema for the LiteLLM budget resource.
+func ResourceBudget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBudgetCreate,
+		ReadContext:   resourceBudgetRead,
+		UpdateContext: resourceBudgetUpdate,
+		DeleteContext: resourceBudgetDelete,
+		Importer:      BudgetImporter(),
+		Schema:        resourceBudgetSchema(),
+	}
+}
+
+// resourceBudgetCreate creates a new budget in LiteLLM.
+func resourceBudgetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Creating LiteLLM budget")
+
+	client := m.(*litellm.Client)
+
+	request := buildBudgetRequest(d, d.Get("budget_id").(string))
+
+	// Generate UUIDv7 if budget_id is not provided
+	if request.BudgetID == "" {
+		budgetUUID, err := uuid.NewV7()
+		if err != nil {
+			return diag.Errorf("failed to generate budget ID: %v", err)
+		}
+		request.BudgetID = budgetUUID.String()
+	}
+
+	budgetResp, err := createBudget(ctx, client, request)
+	if err != nil {
+		return diag.Errorf("error creating budget: %v", err)
+	}
+
+	budgetID := budgetResp.BudgetID
+	if budgetID == "" {
+		budgetID = request.BudgetID
+	}
+
+	d.SetId(budgetID)
+	tflog.Info(ctx, "Created budget", map[string]interface{}{"budget_id": budgetID})
+
+	return resourceBudgetRead(ctx, d, m)
+}
+
+// resourceBudgetRead reads the current state of a budget from LiteLLM.
+func resourceBudgetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM budget", map[string]interface{}{"budget_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	budgetResp, err := getBudget(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error reading budget: %v", err)
+	}
+
+	if budgetResp == nil {
+		tflog.Warn(ctx, "Budget not found, removing from state", map[string]interface{}{"budget_id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := setBudgetResourceData(d, budgetResp); err != nil {
+		return diag.Errorf("error setting budget data: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully read budget", map[string]interface{}{"budget_id": d.Id()})
+	return nil
+}
+
+// resourceBudgetUpdate updates an existing budget in LiteLLM.
+func resourceBudgetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM budget", map[string]interface{}{"budget_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	request := buildBudgetRequest(d, d.Id())
+
+	if _, err := updateBudget(ctx, client, request); err != nil {
+		return diag.Errorf("error updating budget: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully updated budget", map[string]interface{}{"budget_id": d.Id()})
+	return resourceBudgetRead(ctx, d, m)
+}
+
+// resourceBudgetDelete deletes a budget from LiteLLM.
+func resourceBudgetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM budget", map[string]interface{}{"budget_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if err := deleteBudget(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error deleting budget: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully deleted budget", map[string]interface{}{"budget_id": d.Id()})
+	return nil
+}
@@ -0,0 +1,115 @@
+package budget
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceBudgetSchema returns the schema for the budget resource
+func resourceBudgetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"budget_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Unique identifier for the budget. If not set, a unique id will be generated.",
+		},
+		"max_budget": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Maximum spend allowed within the budget period",
+		},
+		"soft_budget": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Soft budget limit that triggers alerts but doesn't block requests",
+		},
+		"max_parallel_requests": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximum number of parallel requests allowed",
+		},
+		"tpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Tokens per minute limit",
+		},
+		"rpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Requests per minute limit",
+		},
+		"budget_duration": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(\d+[smhd])$`),
+				"Budget duration must be in format: number followed by 's' (seconds), 'm' (minutes), 'h' (hours), or 'd' (days). Examples: '30s', '30m', '30h', '30d'",
+			),
+			Description: "Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ('30s'), minutes ('30m'), hours ('30h'), days ('30d').",
+		},
+		"model_max_budget": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Model-specific budgets",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"model": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the model the budget applies to",
+					},
+					"max_budget": {
+						Type:        schema.TypeFloat,
+						Optional:    true,
+						Description: "Maximum budget for the model",
+					},
+					"budget_duration": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Duration after which the model budget is reset",
+					},
+					"tpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Tokens per minute limit for the model",
+					},
+					"rpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Requests per minute limit for the model",
+					},
+				},
+			},
+		},
+		// Computed fields
+		"budget_reset_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the budget will be reset",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "User who created the budget",
+		},
+		"updated_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "User who last updated the budget",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the budget was created",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the budget was last updated",
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package cloudzero
+
+// CloudZeroInitRequest represents the request payload for /cloudzero/init
+type CloudZeroInitRequest struct {
+	APIKey       string `json:"api_key"`
+	ConnectionID string `json:"connection_id"`
+	Timezone     string `json:"timezone,omitempty"`
+}
+
+// CloudZeroSettingsUpdateRequest represents the request payload for updating the CloudZero settings
+type CloudZeroSettingsUpdateRequest struct {
+	APIKey       *string `json:"api_key,omitempty"`
+	ConnectionID *string `json:"connection_id,omitempty"`
+	Timezone     *string `json:"timezone,omitempty"`
+}
+
+// CloudZeroSettingsResponse represents the response of GET /cloudzero/settings.
+// The API key is only returned masked.
+type CloudZeroSettingsResponse struct {
+	APIKeyMasked *string `json:"api_key_masked"`
+	ConnectionID *string `json:"connection_id"`
+	Timezone     *string `json:"timezone"`
+	Status       *string `json:"status"`
+}
+
+// CloudZeroExportRequest represents the request payload for /cloudzero/dry-run and /cloudzero/export
+type CloudZeroExportRequest struct {
+	Limit *int `json:"limit,omitempty"`
+}
+
+// CloudZeroExportResponse represents the response of /cloudzero/dry-run and /cloudzero/export
+type CloudZeroExportResponse struct {
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
@@ -0,0 +1,73 @@
+package cloudzero
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// dryRunLimit is the number of spend records checked by a dry-run export
+const dryRunLimit = 10
+
+// initCloudZero configures the CloudZero integration
+func initCloudZero(ctx context.Context, c *litellm.Client, request *CloudZeroInitRequest) error {
+	_, err := litellm.SendRequestTyped[CloudZeroInitRequest, interface{}](
+		ctx, c, http.MethodPost, "/cloudzero/init", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize CloudZero: %w", err)
+	}
+
+	return nil
+}
+
+// getCloudZeroSettings retrieves the CloudZero settings. It returns nil when CloudZero is not configured.
+func getCloudZeroSettings(ctx context.Context, c *litellm.Client) (*CloudZeroSettingsResponse, error) {
+	response, err := litellm.SendRequestTyped[interface{}, CloudZeroSettingsResponse](
+		ctx, c, http.MethodGet, "/cloudzero/settings", nil,
+	)
+	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) || litellm.MessageContains(err, "not configured") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get CloudZero settings: %w", err)
+	}
+
+	if response.ConnectionID == nil || *response.ConnectionID == "" {
+		return nil, nil
+	}
+
+	return response, nil
+}
+
+// updateCloudZeroSettings updates the CloudZero settings
+func updateCloudZeroSettings(ctx context.Context, c *litellm.Client, request *CloudZeroSettingsUpdateRequest) error {
+	_, err := litellm.SendRequestTyped[CloudZeroSettingsUpdateRequest, interface{}](
+		ctx, c, http.MethodPut, "/cloudzero/settings", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update CloudZero settings: %w", err)
+	}
+
+	return nil
+}
+
+// dryRunCloudZeroExport runs an export without sending data to CloudZero and returns an error if it would fail
+func dryRunCloudZeroExport(ctx context.Context, c *litellm.Client) error {
+	limit := dryRunLimit
+	response, err := litellm.SendRequestTyped[CloudZeroExportRequest, CloudZeroExportResponse](
+		ctx, c, http.MethodPost, "/cloudzero/dry-run", &CloudZeroExportRequest{Limit: &limit},
+	)
+	if err != nil {
+		return fmt.Errorf("CloudZero dry-run failed: %w", err)
+	}
+
+	if response.Status != "" && response.Status != "success" {
+		return fmt.Errorf("CloudZero dry-run failed with status %s: %s", response.Status, response.Message)
+	}
+
+	return nil
+}
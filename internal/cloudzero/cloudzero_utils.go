@@ -0,0 +1,56 @@
+package cloudzero
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// buildCloudZeroInitRequest builds a CloudZeroInitRequest from Terraform resource data
+func buildCloudZeroInitRequest(d *schema.ResourceData) *CloudZeroInitRequest {
+	return &CloudZeroInitRequest{
+		APIKey:       d.Get("api_key").(string),
+		ConnectionID: d.Get("connection_id").(string),
+		Timezone:     d.Get("timezone").(string),
+	}
+}
+
+// buildCloudZeroSettingsUpdateRequest builds a CloudZeroSettingsUpdateRequest from Terraform resource data.
+// Only changed fields are included; nil is returned when none of the settings changed.
+func buildCloudZeroSettingsUpdateRequest(d *schema.ResourceData) *CloudZeroSettingsUpdateRequest {
+	if !d.HasChanges("api_key", "connection_id", "timezone") {
+		return nil
+	}
+
+	request := &CloudZeroSettingsUpdateRequest{}
+
+	if d.HasChange("api_key") {
+		request.APIKey = utils.StringPtr(d.Get("api_key").(string))
+	}
+	if d.HasChange("connection_id") {
+		request.ConnectionID = utils.StringPtr(d.Get("connection_id").(string))
+	}
+	if d.HasChange("timezone") {
+		request.Timezone = utils.StringPtr(d.Get("timezone").(string))
+	}
+
+	return request
+}
+
+// setCloudZeroSettingsResourceData sets Terraform resource data from a CloudZeroSettingsResponse.
+// The API key is only returned masked, so api_key is kept from state.
+func setCloudZeroSettingsResourceData(d *schema.ResourceData, settings *CloudZeroSettingsResponse) error {
+	fields := map[string]interface{}{
+		"connection_id":  settings.ConnectionID,
+		"timezone":       settings.Timezone,
+		"api_key_masked": settings.APIKeyMasked,
+	}
+
+	for field, value := range fields {
+		// Use SetIfNotZero to preserve existing values when API doesn't return them
+		if v, ok := value.(*string); ok && v != nil {
+			utils.SetIfNotZero(d, field, *v)
+		}
+	}
+
+	return nil
+}
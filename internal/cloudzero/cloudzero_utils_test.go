@@ -0,0 +1,104 @@
+package cloudzero
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBuildCloudZeroInitRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]interface{}
+		expected *CloudZeroInitRequest
+	}{
+		{
+			name: "all fields",
+			input: map[string]interface{}{
+				"api_key":       "cz-api-key",
+				"connection_id": "conn-123",
+				"timezone":      "America/New_York",
+			},
+			expected: &CloudZeroInitRequest{
+				APIKey:       "cz-api-key",
+				ConnectionID: "conn-123",
+				Timezone:     "America/New_York",
+			},
+		},
+		{
+			name: "default timezone",
+			input: map[string]interface{}{
+				"api_key":       "cz-api-key",
+				"connection_id": "conn-123",
+			},
+			expected: &CloudZeroInitRequest{
+				APIKey:       "cz-api-key",
+				ConnectionID: "conn-123",
+				Timezone:     "UTC",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceCloudZeroSettingsSchema(), tt.input)
+
+			result := buildCloudZeroInitRequest(d)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildCloudZeroInitRequest() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetCloudZeroSettingsResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudZeroSettingsSchema(), map[string]interface{}{
+		"api_key":       "cz-api-key",
+		"connection_id": "conn-123",
+	})
+
+	settings := &CloudZeroSettingsResponse{
+		APIKeyMasked: stringPtr("cz-a****key"),
+		ConnectionID: stringPtr("conn-456"),
+		Timezone:     stringPtr("Europe/Berlin"),
+	}
+
+	if err := setCloudZeroSettingsResourceData(d, settings); err != nil {
+		t.Fatalf("setCloudZeroSettingsResourceData() error = %v", err)
+	}
+
+	expected := map[string]string{
+		"api_key":        "cz-api-key",
+		"connection_id":  "conn-456",
+		"timezone":       "Europe/Berlin",
+		"api_key_masked": "cz-a****key",
+	}
+	for field, want := range expected {
+		if got := d.Get(field).(string); got != want {
+			t.Errorf("%s = %q, want %q", field, got, want)
+		}
+	}
+}
+
+func TestResourceCloudZeroSettingsDeleteOnlyRemovesState(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudZeroSettingsSchema(), map[string]interface{}{})
+	d.SetId(cloudZeroSettingsID)
+
+	// No client is passed, the delete must not call the API
+	diags := resourceCloudZeroSettingsDelete(context.Background(), d, nil)
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a single warning, got %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected the resource to be removed from state, got ID %q", d.Id())
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,13 @@
+package cloudzero
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CloudZeroSettingsImporter provides import functionality for the LiteLLM CloudZero settings resource.
+// The import ID is expected to be cloudzero_settings.
+func CloudZeroSettingsImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
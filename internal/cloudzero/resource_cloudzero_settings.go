@@ -0,0 +1,116 @@
+package cloudzero
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+const cloudZeroSettingsID = "cloudzero_settings"
+
+// ResourceCloudZeroSettings defines the schema for the LiteLLM CloudZero integration singleton resource.
+func ResourceCloudZeroSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudZeroSettingsCreate,
+		ReadContext:   resourceCloudZeroSettingsRead,
+		UpdateContext: resourceCloudZeroSettingsUpdate,
+		DeleteContext: resourceCloudZeroSettingsDelete,
+		Importer:      CloudZeroSettingsImporter(),
+		Schema:        resourceCloudZeroSettingsSchema(),
+	}
+}
+
+// resourceCloudZeroSettingsCreate configures the CloudZero integration in LiteLLM.
+func resourceCloudZeroSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Configuring LiteLLM CloudZero integration")
+
+	client := m.(*litellm.Client)
+
+	if err := initCloudZero(ctx, client, buildCloudZeroInitRequest(d)); err != nil {
+		return diag.Errorf("error configuring CloudZero: %v", err)
+	}
+
+	d.SetId(cloudZeroSettingsID)
+
+	if diags := validateCloudZeroExport(ctx, d, client); diags.HasError() {
+		return diags
+	}
+
+	return resourceCloudZeroSettingsRead(ctx, d, m)
+}
+
+// resourceCloudZeroSettingsRead reads the CloudZero settings from LiteLLM.
+func resourceCloudZeroSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM CloudZero settings")
+
+	client := m.(*litellm.Client)
+
+	settings, err := getCloudZeroSettings(ctx, client)
+	if err != nil {
+		return diag.Errorf("error reading CloudZero settings: %v", err)
+	}
+
+	if settings == nil {
+		tflog.Warn(ctx, "CloudZero is not configured, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	if err := setCloudZeroSettingsResourceData(d, settings); err != nil {
+		return diag.Errorf("error setting CloudZero settings data: %v", err)
+	}
+
+	return nil
+}
+
+// resourceCloudZeroSettingsUpdate updates the CloudZero settings in LiteLLM.
+func resourceCloudZeroSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM CloudZero settings")
+
+	client := m.(*litellm.Client)
+
+	if request := buildCloudZeroSettingsUpdateRequest(d); request != nil {
+		if err := updateCloudZeroSettings(ctx, client, request); err != nil {
+			return diag.Errorf("error updating CloudZero settings: %v", err)
+		}
+	}
+
+	if diags := validateCloudZeroExport(ctx, d, client); diags.HasError() {
+		return diags
+	}
+
+	return resourceCloudZeroSettingsRead(ctx, d, m)
+}
+
+// resourceCloudZeroSettingsDelete removes the CloudZero settings from state.
+// LiteLLM has no endpoint to remove the CloudZero integration, so the settings stay configured on the proxy.
+func resourceCloudZeroSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Removing LiteLLM CloudZero settings from state")
+
+	d.SetId("")
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "CloudZero settings were only removed from state",
+		Detail: "LiteLLM has no API to remove the CloudZero integration, so the settings remain configured on the proxy " +
+			"and the export keeps running. Remove them from the proxy manually to stop the export.",
+	}}
+}
+
+// validateCloudZeroExport runs a dry-run export when validate_on_apply is set
+func validateCloudZeroExport(ctx context.Context, d *schema.ResourceData, client *litellm.Client) diag.Diagnostics {
+	if !d.Get("validate_on_apply").(bool) {
+		return nil
+	}
+
+	tflog.Info(ctx, "Running CloudZero dry-run export")
+
+	if err := dryRunCloudZeroExport(ctx, client); err != nil {
+		return diag.Errorf("CloudZero settings were saved but the export would be rejected: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package cloudzero
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudZeroSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "CloudZero API key",
+		},
+		"connection_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "ID of the CloudZero connection that receives the spend data",
+		},
+		"timezone": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "UTC",
+			Description: "Timezone used to bucket spend into hours, e.g. UTC or America/New_York",
+		},
+		"validate_on_apply": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Run a CloudZero dry-run export after writing the settings and fail the apply if it is rejected",
+		},
+		"api_key_masked": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Masked API key as returned by LiteLLM",
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package customer
+
+import "github.com/scalepad/terraform-provider-litellm/internal/budget"
+
+// CustomerCreateRequest represents the request body for /customer/new.
+// The budget limits create a budget for the customer unless an existing budget_id is given.
+type CustomerCreateRequest struct {
+	UserID             string  `json:"user_id"`
+	Alias              *string `json:"alias,omitempty"`
+	Blocked            bool    `json:"blocked"`
+	BudgetID           *string `json:"budget_id,omitempty"`
+	AllowedModelRegion *string `json:"allowed_model_region,omitempty"`
+	DefaultModel       *string `json:"default_model,omitempty"`
+	budget.BudgetLimits
+}
+
+// CustomerUpdateRequest represents the request body for /customer/update
+type CustomerUpdateRequest struct {
+	UserID             string  `json:"user_id"`
+	Alias              *string `json:"alias,omitempty"`
+	BudgetID           *string `json:"budget_id,omitempty"`
+	AllowedModelRegion *string `json:"allowed_model_region,omitempty"`
+	DefaultModel       *string `json:"default_model,omitempty"`
+}
+
+// CustomerIDsRequest represents the request body for /customer/delete, /customer/block and /customer/unblock
+type CustomerIDsRequest struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// CustomerResponse represents a customer as returned by the API
+type CustomerResponse struct {
+	UserID             string                     `json:"user_id"`
+	Alias              string                     `json:"alias"`
+	Blocked            bool                       `json:"blocked"`
+	Spend              float64                    `json:"spend"`
+	BudgetID           string                     `json:"budget_id"`
+	AllowedModelRegion string                     `json:"allowed_model_region"`
+	DefaultModel       string                     `json:"default_model"`
+	LitellmBudgetTable *budget.LitellmBudgetTable `json:"litellm_budget_table"`
+}
@@ -0,0 +1,105 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// createCustomer creates a new customer using the typed request/response pattern
+func createCustomer(ctx context.Context, c *litellm.Client, request *CustomerCreateRequest) (*CustomerResponse, error) {
+	response, err := litellm.SendRequestTyped[CustomerCreateRequest, CustomerResponse](
+		ctx, c, http.MethodPost, "/customer/new", request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create customer: %w", err)
+	}
+
+	return response, nil
+}
+
+// getCustomer retrieves customer information by customer (end user) ID
+func getCustomer(ctx context.Context, c *litellm.Client, userID string) (*CustomerResponse, error) {
+	response, err := litellm.SendRequestTyped[interface{}, CustomerResponse](
+		ctx, c, http.MethodGet, fmt.Sprintf("/customer/info?end_user_id=%s", url.QueryEscape(userID)), nil,
+	)
+	if err != nil {
+		// LiteLLM reports unknown customers with "does not exist" rather than a 404
+		if litellm.IsNotFound(err) || litellm.MessageContains(err, "does not exist") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+
+	return response, nil
+}
+
+// updateCustomer updates an existing customer using the typed request pattern
+func updateCustomer(ctx context.Context, c *litellm.Client, request *CustomerUpdateRequest) error {
+	_, err := litellm.SendRequestTyped[CustomerUpdateRequest, interface{}](
+		ctx, c, http.MethodPost, "/customer/update", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update customer: %w", err)
+	}
+
+	return nil
+}
+
+// updateCustomerBudget updates the budget attached to a customer.
+// /customer/update does not accept the full set of budget limits, so they are changed on the budget itself.
+func updateCustomerBudget(ctx context.Context, c *litellm.Client, request *budget.BudgetRequest) error {
+	_, err := litellm.SendRequestTyped[budget.BudgetRequest, interface{}](
+		ctx, c, http.MethodPost, "/budget/update", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update customer budget: %w", err)
+	}
+
+	return nil
+}
+
+// setCustomerBlocked blocks or unblocks a customer through the dedicated endpoints
+func setCustomerBlocked(ctx context.Context, c *litellm.Client, userID string, blocked bool) error {
+	path := "/customer/unblock"
+	if blocked {
+		path = "/customer/block"
+	}
+
+	request := &CustomerIDsRequest{
+		UserIDs: []string{userID},
+	}
+
+	_, err := litellm.SendRequestTyped[CustomerIDsRequest, interface{}](
+		ctx, c, http.MethodPost, path, request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// deleteCustomer deletes a customer by customer (end user) ID
+func deleteCustomer(ctx context.Context, c *litellm.Client, userID string) error {
+	deleteRequest := &CustomerIDsRequest{
+		UserIDs: []string{userID},
+	}
+
+	_, err := litellm.SendRequestTyped[CustomerIDsRequest, interface{}](
+		ctx, c, http.MethodPost, "/customer/delete", deleteRequest,
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) || litellm.MessageContains(err, "does not exist") {
+			return nil
+		}
+		return fmt.Errorf("failed to delete customer: %w", err)
+	}
+
+	return nil
+}
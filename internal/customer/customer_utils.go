@@ -0,0 +1,121 @@
+package customer
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// buildCustomerCreateRequest builds a CustomerCreateRequest from Terraform resource data
+func buildCustomerCreateRequest(d *schema.ResourceData) *CustomerCreateRequest {
+	request := &CustomerCreateRequest{
+		UserID: d.Get("user_id").(string),
+		// Boolean fields - use d.Get() to include false values
+		Blocked: d.Get("blocked").(bool),
+	}
+
+	// String fields
+	if v, ok := d.GetOk("alias"); ok {
+		request.Alias = utils.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("budget_id"); ok {
+		request.BudgetID = utils.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("default_model"); ok {
+		request.DefaultModel = utils.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("allowed_model_region"); ok {
+		request.AllowedModelRegion = utils.StringPtr(v.(string))
+	}
+
+	// Budget limits are used to create the customer's budget
+	request.BudgetLimits = budget.ExpandBudgetLimits(d)
+
+	return request
+}
+
+// buildCustomerUpdateRequest builds a CustomerUpdateRequest from Terraform resource data
+// Only includes fields that have changed
+func buildCustomerUpdateRequest(d *schema.ResourceData, userID string) *CustomerUpdateRequest {
+	request := &CustomerUpdateRequest{
+		UserID: userID,
+	}
+
+	if d.HasChange("alias") {
+		request.Alias = utils.StringPtr(d.Get("alias").(string))
+	}
+	if d.HasChange("budget_id") {
+		request.BudgetID = utils.StringPtr(d.Get("budget_id").(string))
+	}
+	if d.HasChange("default_model") {
+		request.DefaultModel = utils.StringPtr(d.Get("default_model").(string))
+	}
+	if d.HasChange("allowed_model_region") {
+		request.AllowedModelRegion = utils.StringPtr(d.Get("allowed_model_region").(string))
+	}
+
+	return request
+}
+
+// buildCustomerBudgetUpdateRequest builds the budget update payload for the customer's own budget.
+// It returns nil when none of the budget fields have changed or the customer uses a shared budget.
+func buildCustomerBudgetUpdateRequest(d *schema.ResourceData) *budget.BudgetRequest {
+	if !d.HasChanges(budget.LimitFields...) || budget.UsesSharedBudget(d) {
+		return nil
+	}
+
+	budgetID := d.Get("budget_id").(string)
+	if budgetID == "" {
+		return nil
+	}
+
+	return &budget.BudgetRequest{
+		BudgetID:     budgetID,
+		BudgetLimits: budget.ExpandBudgetLimits(d),
+	}
+}
+
+// setCustomerResourceData sets Terraform resource data from a CustomerResponse
+func setCustomerResourceData(d *schema.ResourceData, customer *CustomerResponse) error {
+	// Decide before budget_id is read back, an imported customer has none in state yet
+	sharedBudget := budget.UsesSharedBudget(d)
+
+	budgetID := customer.BudgetID
+	if budgetID == "" && customer.LitellmBudgetTable != nil {
+		budgetID = customer.LitellmBudgetTable.BudgetID
+	}
+
+	fields := map[string]interface{}{
+		"user_id":              customer.UserID,
+		"alias":                customer.Alias,
+		"budget_id":            budgetID,
+		"default_model":        customer.DefaultModel,
+		"allowed_model_region": customer.AllowedModelRegion,
+	}
+
+	for field, value := range fields {
+		// Use SetIfNotZero to preserve existing values when API doesn't return them
+		utils.SetIfNotZero(d, field, value)
+	}
+
+	// Always set blocked and computed spend, including false and zero values
+	if err := d.Set("blocked", customer.Blocked); err != nil {
+		return err
+	}
+	if err := d.Set("spend", customer.Spend); err != nil {
+		return err
+	}
+
+	// Budget limits are returned through the attached budget table.
+	// The limits of a shared budget belong to its own resource and are not copied into the customer.
+	if customer.LitellmBudgetTable != nil {
+		utils.SetIfNotZero(d, "budget_reset_at", customer.LitellmBudgetTable.BudgetResetAt)
+	}
+	if !sharedBudget {
+		if err := budget.SetBudgetLimits(d, customer.LitellmBudgetTable); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
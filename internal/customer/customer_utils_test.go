@@ -0,0 +1,256 @@
+package customer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils/testutil"
+)
+
+func TestBuildCustomerCreateRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]interface{}
+		expected *CustomerCreateRequest
+	}{
+		{
+			name: "inline budget",
+			input: map[string]interface{}{
+				"user_id":              "tenant-123",
+				"alias":                "Acme Inc",
+				"blocked":              true,
+				"default_model":        "gpt-4o",
+				"allowed_model_region": "eu",
+				"max_budget":           100.0,
+				"tpm_limit":            10000,
+				"budget_duration":      "30d",
+			},
+			expected: &CustomerCreateRequest{
+				UserID:             "tenant-123",
+				Alias:              stringPtr("Acme Inc"),
+				Blocked:            true,
+				DefaultModel:       stringPtr("gpt-4o"),
+				AllowedModelRegion: stringPtr("eu"),
+				BudgetLimits: budget.BudgetLimits{
+					MaxBudget:      utils.NullableValue(100.0),
+					TPMLimit:       utils.NullableValue(10000),
+					BudgetDuration: utils.NullableValue("30d"),
+				},
+			},
+		},
+		{
+			name: "shared budget",
+			input: map[string]interface{}{
+				"user_id":   "tenant-456",
+				"budget_id": "budget-1",
+			},
+			expected: &CustomerCreateRequest{
+				UserID:   "tenant-456",
+				BudgetID: stringPtr("budget-1"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := ResourceCustomer()
+			d := schema.TestResourceDataRaw(t, resource.Schema, tt.input)
+
+			result := buildCustomerCreateRequest(d)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildCustomerCreateRequest() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetCustomerResourceData(t *testing.T) {
+	tests := []struct {
+		name             string
+		customerResp     *CustomerResponse
+		expectedBudgetID string
+	}{
+		{
+			name: "budget from budget table",
+			customerResp: &CustomerResponse{
+				UserID:             "tenant-123",
+				Alias:              "Acme Inc",
+				Blocked:            true,
+				Spend:              4.2,
+				AllowedModelRegion: "eu",
+				LitellmBudgetTable: &budget.LitellmBudgetTable{
+					BudgetID:       "budget-1",
+					MaxBudget:      float64Ptr(100.0),
+					BudgetDuration: stringPtr("30d"),
+				},
+			},
+			expectedBudgetID: "budget-1",
+		},
+		{
+			name: "minimal customer data",
+			customerResp: &CustomerResponse{
+				UserID: "tenant-minimal",
+			},
+			expectedBudgetID: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := ResourceCustomer()
+			d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+
+			if err := setCustomerResourceData(d, tt.customerResp); err != nil {
+				t.Fatalf("setCustomerResourceData() unexpected error: %v", err)
+			}
+
+			if d.Get("user_id") != tt.customerResp.UserID {
+				t.Errorf("Expected user_id %s, got %v", tt.customerResp.UserID, d.Get("user_id"))
+			}
+			if d.Get("blocked") != tt.customerResp.Blocked {
+				t.Errorf("Expected blocked %t, got %v", tt.customerResp.Blocked, d.Get("blocked"))
+			}
+			if d.Get("spend") != tt.customerResp.Spend {
+				t.Errorf("Expected spend %f, got %v", tt.customerResp.Spend, d.Get("spend"))
+			}
+			if d.Get("budget_id") != tt.expectedBudgetID {
+				t.Errorf("Expected budget_id %s, got %v", tt.expectedBudgetID, d.Get("budget_id"))
+			}
+
+			if table := tt.customerResp.LitellmBudgetTable; table != nil && table.MaxBudget != nil {
+				if d.Get("max_budget") != *table.MaxBudget {
+					t.Errorf("Expected max_budget %f, got %v", *table.MaxBudget, d.Get("max_budget"))
+				}
+			}
+		})
+	}
+}
+
+func TestSetCustomerResourceDataSharedBudget(t *testing.T) {
+	resource := ResourceCustomer()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"user_id":   "tenant-123",
+		"budget_id": "shared-budget",
+	})
+
+	customerResp := &CustomerResponse{
+		UserID:   "tenant-123",
+		BudgetID: "shared-budget",
+		LitellmBudgetTable: &budget.LitellmBudgetTable{
+			BudgetID:  "shared-budget",
+			MaxBudget: float64Ptr(50.0),
+			TPMLimit:  intPtr(1000),
+		},
+	}
+
+	if err := setCustomerResourceData(d, customerResp); err != nil {
+		t.Fatalf("setCustomerResourceData() unexpected error: %v", err)
+	}
+
+	// The shared budget's limits must not end up in the customer, they are not in its configuration
+	if v, ok := d.GetOk("max_budget"); ok {
+		t.Errorf("Expected max_budget to stay unset, got %v", v)
+	}
+	if v, ok := d.GetOk("tpm_limit"); ok {
+		t.Errorf("Expected tpm_limit to stay unset, got %v", v)
+	}
+}
+
+func TestBuildCustomerBudgetUpdateRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    map[string]interface{}
+		config   map[string]interface{}
+		expected *budget.BudgetRequest
+	}{
+		{
+			name:   "own budget is updated",
+			state:  map[string]interface{}{"user_id": "tenant-123", "budget_id": "budget-1", "max_budget": 100.0, "tpm_limit": 1000},
+			config: map[string]interface{}{"user_id": "tenant-123", "max_budget": 200.0},
+			expected: &budget.BudgetRequest{
+				BudgetID: "budget-1",
+				BudgetLimits: budget.BudgetLimits{
+					MaxBudget: utils.NullableValue(200.0),
+					TPMLimit:  utils.NullValue[int](),
+				},
+			},
+		},
+		{
+			name:     "unchanged limits",
+			state:    map[string]interface{}{"user_id": "tenant-123", "budget_id": "budget-1", "max_budget": 100.0},
+			config:   map[string]interface{}{"user_id": "tenant-123", "max_budget": 100.0, "alias": "Acme"},
+			expected: nil,
+		},
+		{
+			name:     "shared budget is never updated",
+			state:    map[string]interface{}{"user_id": "tenant-123", "budget_id": "budget-1", "max_budget": 100.0},
+			config:   map[string]interface{}{"user_id": "tenant-123", "budget_id": "shared-budget"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testutil.ResourceDataWithState(t, ResourceCustomer(), tt.state, tt.config)
+
+			result := buildCustomerBudgetUpdateRequest(d)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildCustomerBudgetUpdateRequest() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCustomerBudgetIDConflictsWithLimits(t *testing.T) {
+	diags := ResourceCustomer().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"user_id":    "tenant-123",
+		"budget_id":  "shared-budget",
+		"max_budget": 100.0,
+	}))
+
+	if !diags.HasError() {
+		t.Errorf("Expected budget_id and max_budget to conflict")
+	}
+}
+
+func TestCustomerReplacedWhenLeavingSharedBudget(t *testing.T) {
+	resource := ResourceCustomer()
+
+	prior := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"user_id":   "tenant-123",
+		"budget_id": "shared-budget",
+	})
+	prior.SetId("tenant-123")
+
+	diff, err := resource.Diff(context.Background(), prior.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"user_id":    "tenant-123",
+		"max_budget": 100.0,
+	}), nil)
+	if err != nil {
+		t.Fatalf("Diff() unexpected error: %v", err)
+	}
+
+	if !diff.RequiresNew() {
+		t.Errorf("Expected moving from a shared budget to inline limits to replace the customer")
+	}
+}
+
+// Helper functions for creating pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,12 @@
+package customer
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CustomerImporter provides import functionality for LiteLLM customer resources
+func CustomerImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
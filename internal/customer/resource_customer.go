@@ -0,0 +1,122 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceCustomer defines the schema for the LiteLLM customer (end user) resource.
+func ResourceCustomer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomerCreate,
+		ReadContext:   resourceCustomerRead,
+		UpdateContext: resourceCustomerUpdate,
+		DeleteContext: resourceCustomerDelete,
+		Importer:      CustomerImporter(),
+		Schema:        resourceCustomerSchema(),
+		CustomizeDiff: budget.ReplaceOnSharedBudgetRemoval,
+	}
+}
+
+// resourceCustomerCreate creates a new customer in LiteLLM.
+func resourceCustomerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Creating LiteLLM customer")
+
+	client := m.(*litellm.Client)
+
+	request := buildCustomerCreateRequest(d)
+
+	if _, err := createCustomer(ctx, client, request); err != nil {
+		return diag.Errorf("error creating customer: %v", err)
+	}
+
+	d.SetId(request.UserID)
+	tflog.Info(ctx, "Created customer", map[string]interface{}{"user_id": request.UserID})
+
+	return resourceCustomerRead(ctx, d, m)
+}
+
+// resourceCustomerRead reads the current state of a customer from LiteLLM.
+func resourceCustomerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM customer", map[string]interface{}{"user_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	customerResp, err := getCustomer(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error reading customer: %v", err)
+	}
+
+	if customerResp == nil {
+		tflog.Warn(ctx, "Customer not found, removing from state", map[string]interface{}{"user_id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := setCustomerResourceData(d, customerResp); err != nil {
+		return diag.Errorf("error setting customer data: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully read customer", map[string]interface{}{"user_id": d.Id()})
+	return nil
+}
+
+// resourceCustomerUpdate updates an existing customer in LiteLLM.
+func resourceCustomerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM customer", map[string]interface{}{"user_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if d.HasChanges("alias", "budget_id", "default_model", "allowed_model_region") {
+		request := buildCustomerUpdateRequest(d, d.Id())
+		if err := updateCustomer(ctx, client, request); err != nil {
+			return diag.Errorf("error updating customer: %v", err)
+		}
+	}
+
+	if budgetRequest := buildCustomerBudgetUpdateRequest(d); budgetRequest != nil {
+		tflog.Info(ctx, "Updating customer budget", map[string]interface{}{
+			"user_id":   d.Id(),
+			"budget_id": budgetRequest.BudgetID,
+		})
+
+		if err := updateCustomerBudget(ctx, client, budgetRequest); err != nil {
+			return diag.Errorf("error updating customer budget: %v", err)
+		}
+	}
+
+	// Blocking is only honoured through the dedicated block/unblock endpoints
+	if d.HasChange("blocked") {
+		blocked := d.Get("blocked").(bool)
+		tflog.Info(ctx, "Updating customer blocked status", map[string]interface{}{
+			"user_id": d.Id(),
+			"blocked": blocked,
+		})
+
+		if err := setCustomerBlocked(ctx, client, d.Id(), blocked); err != nil {
+			return diag.Errorf("error updating customer blocked status: %v", err)
+		}
+	}
+
+	tflog.Info(ctx, "Successfully updated customer", map[string]interface{}{"user_id": d.Id()})
+	return resourceCustomerRead(ctx, d, m)
+}
+
+// resourceCustomerDelete deletes a customer from LiteLLM.
+func resourceCustomerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM customer", map[string]interface{}{"user_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if err := deleteCustomer(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error deleting customer: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully deleted customer", map[string]interface{}{"user_id": d.Id()})
+	return nil
+}
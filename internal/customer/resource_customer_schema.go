@@ -0,0 +1,134 @@
+package customer
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceCustomerSchema returns the schema for the customer resource
+func resourceCustomerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Unique identifier of the customer (end user), as sent in the 'user' field of LLM requests",
+		},
+		"alias": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Human-readable name of the customer",
+		},
+		"blocked": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the customer is blocked from making requests",
+		},
+		"default_model": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Model used for the customer's requests when no model is specified",
+		},
+		"allowed_model_region": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"eu", "us"}, false),
+			Description:  "Restrict the customer's requests to model deployments in this region. Valid values: eu, us",
+		},
+		"budget_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "ID of an existing budget to attach to the customer. Conflicts with the inline limits. If not set, a budget is created from the inline limits.",
+		},
+		"max_budget": {
+			Type:          schema.TypeFloat,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Maximum budget allowed for the customer",
+		},
+		"soft_budget": {
+			Type:          schema.TypeFloat,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Soft budget limit that triggers alerts but doesn't block requests",
+		},
+		"max_parallel_requests": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Maximum number of parallel requests allowed for the customer",
+		},
+		"tpm_limit": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Tokens per minute limit for the customer",
+		},
+		"rpm_limit": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Requests per minute limit for the customer",
+		},
+		"budget_duration": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(\d+[smhd])$`),
+				"Budget duration must be in format: number followed by 's' (seconds), 'm' (minutes), 'h' (hours), or 'd' (days). Examples: '30s', '30m', '30h', '30d'",
+			),
+			Description: "Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ('30s'), minutes ('30m'), hours ('30h'), days ('30d').",
+		},
+		"model_max_budget": {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Model-specific budgets for the customer",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"model": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the model the budget applies to",
+					},
+					"max_budget": {
+						Type:        schema.TypeFloat,
+						Optional:    true,
+						Description: "Maximum budget for the model",
+					},
+					"budget_duration": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Duration after which the model budget is reset",
+					},
+					"tpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Tokens per minute limit for the model",
+					},
+					"rpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Requests per minute limit for the model",
+					},
+				},
+			},
+		},
+		// Computed fields
+		"spend": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Current spend amount for the customer",
+		},
+		"budget_reset_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the customer's budget will be reset",
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package guardrail
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+func DataSourceLiteLLMGuardrails() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLiteLLMGuardrailsRead,
+		Schema:      dataSourceGuardrailsSchema(),
+	}
+}
+
+func dataSourceLiteLLMGuardrailsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*litellm.Client)
+
+	guardrails, err := listGuardrails(ctx, c)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read guardrails: %w", err))
+	}
+
+	names := make([]string, 0)
+	for _, name := range d.Get("names").([]interface{}) {
+		names = append(names, name.(string))
+	}
+
+	selected, err := selectGuardrailsByName(guardrails, names)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	guardrailNames := make([]string, 0, len(selected))
+	for _, guardrail := range selected {
+		guardrailNames = append(guardrailNames, guardrail.GuardrailName)
+	}
+
+	d.SetId("guardrails")
+
+	if err := d.Set("guardrail_names", guardrailNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("guardrails", flattenGuardrailList(selected)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// selectGuardrailsByName returns the guardrails matching names, in the requested order.
+// All guardrails are returned when names is empty; a missing name is an error.
+func selectGuardrailsByName(guardrails []GuardrailResponse, names []string) ([]GuardrailResponse, error) {
+	if len(names) == 0 {
+		return guardrails, nil
+	}
+
+	byName := make(map[string]GuardrailResponse, len(guardrails))
+	for _, guardrail := range guardrails {
+		byName[guardrail.GuardrailName] = guardrail
+	}
+
+	selected := make([]GuardrailResponse, 0, len(names))
+	var missing []string
+	for _, name := range names {
+		guardrail, ok := byName[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		selected = append(selected, guardrail)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("guardrails not found: %s", strings.Join(missing, ", "))
+	}
+
+	return selected, nil
+}
+
+// flattenGuardrailList converts guardrails into the data source's guardrails list
+func flattenGuardrailList(guardrails []GuardrailResponse) []interface{} {
+	result := make([]interface{}, 0, len(guardrails))
+	for _, guardrail := range guardrails {
+		item := map[string]interface{}{
+			"guardrail_id":        guardrail.GuardrailID,
+			"guardrail_name":      guardrail.GuardrailName,
+			"definition_location": guardrail.GuardrailDefinitionLocation,
+		}
+		if v, ok := guardrail.LiteLLMParams["guardrail"].(string); ok {
+			item["guardrail"] = v
+		}
+		if v, ok := guardrail.LiteLLMParams["mode"].(string); ok {
+			item["mode"] = v
+		}
+		if v, ok := guardrail.LiteLLMParams["default_on"].(bool); ok {
+			item["default_on"] = v
+		}
+		result = append(result, item)
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package guardrail
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGuardrailsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"names": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Guardrail names to look up. Reading the data source fails if any of them does not exist. If not set, all guardrails are returned.",
+		},
+		"guardrail_names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Names of the returned guardrails",
+		},
+		"guardrails": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Guardrails known to the LiteLLM proxy",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"guardrail_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Unique identifier of the guardrail",
+					},
+					"guardrail_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the guardrail",
+					},
+					"guardrail": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Guardrail provider type",
+					},
+					"mode": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "When the guardrail runs",
+					},
+					"default_on": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the guardrail runs on every request",
+					},
+					"definition_location": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Where the guardrail is defined: 'db' for guardrails created through the API, 'config' for guardrails from the proxy config file",
+					},
+				},
+			},
+		},
+	}
+}
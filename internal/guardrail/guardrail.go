@@ -0,0 +1,31 @@
+package guardrail
+
+// Guardrail represents the guardrail definition sent to and returned by the /guardrails endpoints
+type Guardrail struct {
+	GuardrailID   *string                `json:"guardrail_id,omitempty"`
+	GuardrailName string                 `json:"guardrail_name"`
+	LiteLLMParams map[string]interface{} `json:"litellm_params"`
+	GuardrailInfo map[string]interface{} `json:"guardrail_info,omitempty"`
+}
+
+// GuardrailRequest represents the request body for creating and updating a guardrail
+type GuardrailRequest struct {
+	Guardrail Guardrail `json:"guardrail"`
+}
+
+// GuardrailResponse represents a guardrail as returned by the API
+type GuardrailResponse struct {
+	GuardrailID   string                 `json:"guardrail_id"`
+	GuardrailName string                 `json:"guardrail_name"`
+	LiteLLMParams map[string]interface{} `json:"litellm_params"`
+	GuardrailInfo map[string]interface{} `json:"guardrail_info"`
+	// GuardrailDefinitionLocation is "db" for guardrails managed through the API and "config" for config.yaml guardrails
+	GuardrailDefinitionLocation string `json:"guardrail_definition_location"`
+	CreatedAt                   string `json:"created_at"`
+	UpdatedAt                   string `json:"updated_at"`
+}
+
+// GuardrailListResponse represents the response from /v2/guardrails/list
+type GuardrailListResponse struct {
+	Guardrails []GuardrailResponse `json:"guardrails"`
+}
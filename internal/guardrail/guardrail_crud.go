@@ -0,0 +1,79 @@
+package guardrail
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// createGuardrail creates a new guardrail using the typed request/response pattern
+func createGuardrail(ctx context.Context, c *litellm.Client, request *GuardrailRequest) (*GuardrailResponse, error) {
+	response, err := litellm.SendRequestTyped[GuardrailRequest, GuardrailResponse](
+		ctx, c, http.MethodPost, "/guardrails", request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create guardrail: %w", err)
+	}
+
+	return response, nil
+}
+
+// getGuardrail retrieves a guardrail by guardrail ID.
+// It returns nil without an error when the guardrail does not exist.
+func getGuardrail(ctx context.Context, c *litellm.Client, guardrailID string) (*GuardrailResponse, error) {
+	response, err := litellm.SendRequestTyped[interface{}, GuardrailResponse](
+		ctx, c, http.MethodGet, fmt.Sprintf("/guardrails/%s", url.PathEscape(guardrailID)), nil,
+	)
+	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get guardrail: %w", err)
+	}
+
+	return response, nil
+}
+
+// listGuardrails retrieves all guardrails, including the ones defined in the proxy config
+func listGuardrails(ctx context.Context, c *litellm.Client) ([]GuardrailResponse, error) {
+	response, err := litellm.SendRequestTyped[interface{}, GuardrailListResponse](
+		ctx, c, http.MethodGet, "/v2/guardrails/list", nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guardrails: %w", err)
+	}
+
+	return response.Guardrails, nil
+}
+
+// updateGuardrail replaces an existing guardrail definition
+func updateGuardrail(ctx context.Context, c *litellm.Client, guardrailID string, request *GuardrailRequest) (*GuardrailResponse, error) {
+	response, err := litellm.SendRequestTyped[GuardrailRequest, GuardrailResponse](
+		ctx, c, http.MethodPut, fmt.Sprintf("/guardrails/%s", url.PathEscape(guardrailID)), request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update guardrail: %w", err)
+	}
+
+	return response, nil
+}
+
+// deleteGuardrail deletes a guardrail by guardrail ID
+func deleteGuardrail(ctx context.Context, c *litellm.Client, guardrailID string) error {
+	_, err := litellm.SendRequestTyped[interface{}, interface{}](
+		ctx, c, http.MethodDelete, fmt.Sprintf("/guardrails/%s", url.PathEscape(guardrailID)), nil,
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete guardrail: %w", err)
+	}
+
+	return nil
+}
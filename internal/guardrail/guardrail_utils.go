@@ -0,0 +1,132 @@
+package guardrail
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// reservedLiteLLMParams are litellm_params keys managed through dedicated schema fields
+var reservedLiteLLMParams = map[string]bool{
+	"guardrail":  true,
+	"mode":       true,
+	"default_on": true,
+}
+
+// buildGuardrailRequest builds a GuardrailRequest from Terraform resource data
+func buildGuardrailRequest(d *schema.ResourceData) *GuardrailRequest {
+	params := expandLiteLLMParams(d.Get("litellm_params").(map[string]interface{}))
+	params["guardrail"] = d.Get("guardrail").(string)
+	params["mode"] = d.Get("mode").(string)
+	// Boolean fields - use d.Get() to include false values
+	params["default_on"] = d.Get("default_on").(bool)
+
+	request := &GuardrailRequest{
+		Guardrail: Guardrail{
+			GuardrailName: d.Get("guardrail_name").(string),
+			LiteLLMParams: params,
+		},
+	}
+
+	if v, ok := d.GetOk("guardrail_info"); ok {
+		request.Guardrail.GuardrailInfo = v.(map[string]interface{})
+	}
+
+	return request
+}
+
+// setGuardrailResourceData sets Terraform resource data from a GuardrailResponse
+func setGuardrailResourceData(d *schema.ResourceData, guardrail *GuardrailResponse) error {
+	fields := map[string]interface{}{
+		"guardrail_id":   guardrail.GuardrailID,
+		"guardrail_name": guardrail.GuardrailName,
+		"created_at":     guardrail.CreatedAt,
+		"updated_at":     guardrail.UpdatedAt,
+	}
+
+	for field, value := range fields {
+		// Use SetIfNotZero to preserve existing values when API doesn't return them
+		utils.SetIfNotZero(d, field, value)
+	}
+
+	if v, ok := guardrail.LiteLLMParams["guardrail"].(string); ok {
+		d.Set("guardrail", v)
+	}
+	if v, ok := guardrail.LiteLLMParams["mode"].(string); ok {
+		d.Set("mode", v)
+	}
+	if v, ok := guardrail.LiteLLMParams["default_on"].(bool); ok {
+		d.Set("default_on", v)
+	}
+
+	stateParams := d.Get("litellm_params").(map[string]interface{})
+	if err := d.Set("litellm_params", flattenLiteLLMParams(guardrail.LiteLLMParams, stateParams)); err != nil {
+		return err
+	}
+
+	if len(guardrail.GuardrailInfo) > 0 {
+		if err := d.Set("guardrail_info", stringifyMap(guardrail.GuardrailInfo)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expandLiteLLMParams converts the litellm_params map into the API representation.
+// Boolean strings are sent as booleans; everything else is left for the API to coerce.
+func expandLiteLLMParams(params map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(params)+3)
+	for key, value := range params {
+		switch value {
+		case "true":
+			result[key] = true
+		case "false":
+			result[key] = false
+		default:
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// flattenLiteLLMParams refreshes the configured litellm_params from the API response.
+// Only keys already tracked in state are refreshed, because the API also returns defaults
+// for every supported parameter. Masked secrets keep their configured value.
+func flattenLiteLLMParams(apiParams map[string]interface{}, stateParams map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(stateParams))
+	for key, stateValue := range stateParams {
+		if reservedLiteLLMParams[key] {
+			continue
+		}
+
+		apiValue, ok := apiParams[key]
+		if !ok || apiValue == nil {
+			result[key] = stateValue
+			continue
+		}
+
+		value := fmt.Sprintf("%v", apiValue)
+		if strings.Contains(value, "**") {
+			result[key] = stateValue
+			continue
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// stringifyMap converts all values of a map to strings for TypeMap fields
+func stringifyMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		if s, ok := value.(string); ok {
+			result[key] = s
+			continue
+		}
+		result[key] = fmt.Sprintf("%v", value)
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package guardrail
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBuildGuardrailRequest(t *testing.T) {
+	resource := ResourceGuardrail()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"guardrail_name": "pii-masking",
+		"guardrail":      "presidio",
+		"mode":           "pre_call",
+		"default_on":     true,
+		"litellm_params": map[string]interface{}{
+			"presidio_analyzer_api_base": "http://presidio:3000",
+			"output_parse_pii":           "false",
+		},
+		"guardrail_info": map[string]interface{}{
+			"description": "Masks PII",
+		},
+	})
+
+	result := buildGuardrailRequest(d)
+
+	expected := &GuardrailRequest{
+		Guardrail: Guardrail{
+			GuardrailName: "pii-masking",
+			LiteLLMParams: map[string]interface{}{
+				"guardrail":                  "presidio",
+				"mode":                       "pre_call",
+				"default_on":                 true,
+				"presidio_analyzer_api_base": "http://presidio:3000",
+				"output_parse_pii":           false,
+			},
+			GuardrailInfo: map[string]interface{}{
+				"description": "Masks PII",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("buildGuardrailRequest() = %+v, want %+v", result, expected)
+	}
+}
+
+func TestFlattenLiteLLMParams(t *testing.T) {
+	apiParams := map[string]interface{}{
+		"guardrail":  "aporia",
+		"mode":       "post_call",
+		"api_base":   "https://aporia.example.com",
+		"api_key":    "sk-a****xyz",
+		"timeout":    30.0,
+		"unexpected": "default",
+	}
+	stateParams := map[string]interface{}{
+		"api_base": "https://old.example.com",
+		"api_key":  "sk-abcdefxyz",
+		"timeout":  "30",
+		"missing":  "kept",
+	}
+
+	result := flattenLiteLLMParams(apiParams, stateParams)
+
+	expected := map[string]interface{}{
+		"api_base": "https://aporia.example.com",
+		"api_key":  "sk-abcdefxyz",
+		"timeout":  "30",
+		"missing":  "kept",
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("flattenLiteLLMParams() = %v, want %v", result, expected)
+	}
+}
+
+func TestSelectGuardrailsByName(t *testing.T) {
+	guardrails := []GuardrailResponse{
+		{GuardrailID: "g-1", GuardrailName: "pii-masking"},
+		{GuardrailID: "g-2", GuardrailName: "prompt-injection"},
+	}
+
+	t.Run("all guardrails when no names given", func(t *testing.T) {
+		result, err := selectGuardrailsByName(guardrails, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result) != 2 {
+			t.Errorf("Expected 2 guardrails, got %d", len(result))
+		}
+	})
+
+	t.Run("selected guardrails in requested order", func(t *testing.T) {
+		result, err := selectGuardrailsByName(guardrails, []string{"prompt-injection", "pii-masking"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result) != 2 || result[0].GuardrailID != "g-2" || result[1].GuardrailID != "g-1" {
+			t.Errorf("Unexpected selection: %+v", result)
+		}
+	})
+
+	t.Run("missing guardrail", func(t *testing.T) {
+		_, err := selectGuardrailsByName(guardrails, []string{"pii-masking", "toxicity"})
+		if err == nil {
+			t.Fatal("Expected an error for a missing guardrail")
+		}
+		if err.Error() != "guardrails not found: toxicity" {
+			t.Errorf("Unexpected error message: %v", err)
+		}
+	})
+}
@@ -0,0 +1,12 @@
+package guardrail
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GuardrailImporter provides import functionality for LiteLLM guardrail resources
+func GuardrailImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
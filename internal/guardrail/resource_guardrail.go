@@ -0,0 +1,101 @@
+package guardrail
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceGuardrail defines the schema for the LiteLLM guardrail resource.
+func ResourceGuardrail() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGuardrailCreate,
+		ReadContext:   resourceGuardrailRead,
+		UpdateContext: resourceGuardrailUpdate,
+		DeleteContext: resourceGuardrailDelete,
+		Importer:      GuardrailImporter(),
+		Schema:        resourceGuardrailSchema(),
+	}
+}
+
+// resourceGuardrailCreate creates a new guardrail in LiteLLM.
+func resourceGuardrailCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Creating LiteLLM guardrail", map[string]interface{}{"guardrail_name": d.Get("guardrail_name")})
+
+	client := m.(*litellm.Client)
+
+	request := buildGuardrailRequest(d)
+
+	guardrailResp, err := createGuardrail(ctx, client, request)
+	if err != nil {
+		return diag.Errorf("error creating guardrail: %v", err)
+	}
+
+	if guardrailResp.GuardrailID == "" {
+		return diag.Errorf("error creating guardrail: API response did not include a guardrail_id")
+	}
+
+	d.SetId(guardrailResp.GuardrailID)
+	tflog.Info(ctx, "Created guardrail", map[string]interface{}{"guardrail_id": guardrailResp.GuardrailID})
+
+	return resourceGuardrailRead(ctx, d, m)
+}
+
+// resourceGuardrailRead reads the current state of a guardrail from LiteLLM.
+func resourceGuardrailRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM guardrail", map[string]interface{}{"guardrail_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	guardrailResp, err := getGuardrail(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error reading guardrail: %v", err)
+	}
+
+	if guardrailResp == nil {
+		tflog.Warn(ctx, "Guardrail not found, removing from state", map[string]interface{}{"guardrail_id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := setGuardrailResourceData(d, guardrailResp); err != nil {
+		return diag.Errorf("error setting guardrail data: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully read guardrail", map[string]interface{}{"guardrail_id": d.Id()})
+	return nil
+}
+
+// resourceGuardrailUpdate updates an existing guardrail in LiteLLM.
+func resourceGuardrailUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM guardrail", map[string]interface{}{"guardrail_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	// The update endpoint replaces the whole definition, so the full request is sent
+	request := buildGuardrailRequest(d)
+
+	if _, err := updateGuardrail(ctx, client, d.Id(), request); err != nil {
+		return diag.Errorf("error updating guardrail: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully updated guardrail", map[string]interface{}{"guardrail_id": d.Id()})
+	return resourceGuardrailRead(ctx, d, m)
+}
+
+// resourceGuardrailDelete deletes a guardrail from LiteLLM.
+func resourceGuardrailDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM guardrail", map[string]interface{}{"guardrail_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if err := deleteGuardrail(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error deleting guardrail: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully deleted guardrail", map[string]interface{}{"guardrail_id": d.Id()})
+	return nil
+}
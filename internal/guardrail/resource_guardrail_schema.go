@@ -0,0 +1,71 @@
+package guardrail
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// guardrailModes lists the points in the request lifecycle where a guardrail can run
+var guardrailModes = []string{
+	"pre_call",
+	"post_call",
+	"during_call",
+	"logging_only",
+}
+
+// resourceGuardrailSchema returns the schema for the guardrail resource
+func resourceGuardrailSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"guardrail_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the guardrail, as referenced from the guardrails list of keys and teams",
+		},
+		"guardrail": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Guardrail provider type, e.g. 'aporia', 'bedrock', 'lakera_v2', 'presidio' or 'guardrails_ai'",
+		},
+		"mode": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(guardrailModes, false),
+			Description:  "When the guardrail runs. Valid values: pre_call, post_call, during_call, logging_only",
+		},
+		"default_on": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the guardrail runs on every request without being requested explicitly",
+		},
+		"litellm_params": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Provider-specific parameters such as api_base, api_key or guardrailIdentifier. Values 'true' and 'false' are sent as booleans.",
+		},
+		"guardrail_info": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Additional information about the guardrail, such as a description",
+		},
+		// Computed fields
+		"guardrail_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Unique identifier of the guardrail",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the guardrail was created",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the guardrail was last updated",
+		},
+	}
+}
@@ -1,6 +1,10 @@
 package key
 
-import "time"
+import (
+	"time"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
 
 // KeyGenerateRequest represents the request payload for creating a new key
 type KeyGenerateRequest struct {
@@ -39,6 +43,9 @@ type KeyGenerateRequest struct {
 	SendInviteEmail bool                   `json:"send_invite_email"`         // Whether to send an invite email
 	Blocked         bool                   `json:"blocked"`                   // Whether the key is blocked
 	EnforcedParams  map[string]interface{} `json:"enforced_params,omitempty"` // List of enforced params for the key
+
+	// Object-level permissions
+	ObjectPermission *utils.ObjectPermission `json:"object_permission,omitempty"` // Key-specific object permission
 }
 
 // KeyGenerateResponse represents the response from creating a new key
@@ -74,7 +81,7 @@ type KeyGenerateResponse struct {
 	Aliases          map[string]interface{}  `json:"aliases"`                   // Model aliases
 	Config           map[string]interface{}  `json:"config"`                    // Additional configuration
 	Permissions      map[string]interface{}  `json:"permissions"`               // Permissions configuration
-	ObjectPermission interface{}             `json:"object_permission"`         // Object-level permissions
+	ObjectPermission *utils.ObjectPermission `json:"object_permission"`         // Object-level permissions
 	ModelMaxBudget   map[string]interface{}  `json:"model_max_budget"`          // Per-model budget limits
 	ModelRPMLimit    *map[string]interface{} `json:"model_rpm_limit,omitempty"` // Per-model RPM limits
 	ModelTPMLimit    *map[string]interface{} `json:"model_tpm_limit,omitempty"` // Per-model TPM limits
@@ -106,39 +113,39 @@ type KeyInfoResponse struct {
 
 // KeyInfo represents the detailed information about a key
 type KeyInfo struct {
-	KeyName              string                 `json:"key_name"`
-	KeyAlias             *string                `json:"key_alias"`
-	SoftBudgetCooldown   bool                   `json:"soft_budget_cooldown"`
-	Spend                float64                `json:"spend"`
-	Expires              *time.Time             `json:"expires"`
-	Models               []string               `json:"models"`
-	Aliases              map[string]interface{} `json:"aliases"`
-	Config               map[string]interface{} `json:"config"`
-	UserID               string                 `json:"user_id"`
-	TeamID               *string                `json:"team_id"`
-	Permissions          map[string]interface{} `json:"permissions"`
-	MaxParallelRequests  *int                   `json:"max_parallel_requests"`
-	Metadata             map[string]interface{} `json:"metadata"`
-	Blocked              *bool                  `json:"blocked"`
-	TPMLimit             *int                   `json:"tpm_limit"`
-	RPMLimit             *int                   `json:"rpm_limit"`
-	MaxBudget            *float64               `json:"max_budget"`
-	BudgetDuration       *string                `json:"budget_duration"`
-	BudgetResetAt        *time.Time             `json:"budget_reset_at"`
-	AllowedCacheControls []string               `json:"allowed_cache_controls"`
-	AllowedRoutes        []string               `json:"allowed_routes"`
-	ModelSpend           map[string]interface{} `json:"model_spend"`
-	ModelMaxBudget       map[string]interface{} `json:"model_max_budget"`
-	BudgetID             *string                `json:"budget_id"`
-	OrganizationID       *string                `json:"organization_id"`
-	ObjectPermissionID   *string                `json:"object_permission_id"`
-	CreatedAt            time.Time              `json:"created_at"`
-	CreatedBy            string                 `json:"created_by"`
-	UpdatedAt            time.Time              `json:"updated_at"`
-	UpdatedBy            string                 `json:"updated_by"`
-	LitellmBudgetTable   interface{}            `json:"litellm_budget_table"`
-	LitellmOrgTable      interface{}            `json:"litellm_organization_table"`
-	ObjectPermission     interface{}            `json:"object_permission"`
+	KeyName              string                  `json:"key_name"`
+	KeyAlias             *string                 `json:"key_alias"`
+	SoftBudgetCooldown   bool                    `json:"soft_budget_cooldown"`
+	Spend                float64                 `json:"spend"`
+	Expires              *time.Time              `json:"expires"`
+	Models               []string                `json:"models"`
+	Aliases              map[string]interface{}  `json:"aliases"`
+	Config               map[string]interface{}  `json:"config"`
+	UserID               string                  `json:"user_id"`
+	TeamID               *string                 `json:"team_id"`
+	Permissions          map[string]interface{}  `json:"permissions"`
+	MaxParallelRequests  *int                    `json:"max_parallel_requests"`
+	Metadata             map[string]interface{}  `json:"metadata"`
+	Blocked              *bool                   `json:"blocked"`
+	TPMLimit             *int                    `json:"tpm_limit"`
+	RPMLimit             *int                    `json:"rpm_limit"`
+	MaxBudget            *float64                `json:"max_budget"`
+	BudgetDuration       *string                 `json:"budget_duration"`
+	BudgetResetAt        *time.Time              `json:"budget_reset_at"`
+	AllowedCacheControls []string                `json:"allowed_cache_controls"`
+	AllowedRoutes        []string                `json:"allowed_routes"`
+	ModelSpend           map[string]interface{}  `json:"model_spend"`
+	ModelMaxBudget       map[string]interface{}  `json:"model_max_budget"`
+	BudgetID             *string                 `json:"budget_id"`
+	OrganizationID       *string                 `json:"organization_id"`
+	ObjectPermissionID   *string                 `json:"object_permission_id"`
+	CreatedAt            time.Time               `json:"created_at"`
+	CreatedBy            string                  `json:"created_by"`
+	UpdatedAt            time.Time               `json:"updated_at"`
+	UpdatedBy            string                  `json:"updated_by"`
+	LitellmBudgetTable   interface{}             `json:"litellm_budget_table"`
+	LitellmOrgTable      interface{}             `json:"litellm_organization_table"`
+	ObjectPermission     *utils.ObjectPermission `json:"object_permission"`
 }
 
 // KeyListRequest represents the request for listing keys
@@ -161,63 +168,63 @@ type KeyListResponse struct {
 
 // KeyListItem represents a single key item in the list response
 type KeyListItem struct {
-	Token                string                 `json:"token"`
-	KeyName              string                 `json:"key_name"`
-	KeyAlias             *string                `json:"key_alias"`
-	Spend                float64                `json:"spend"`
-	MaxBudget            *float64               `json:"max_budget"`
-	Expires              *time.Time             `json:"expires"`
-	Models               []string               `json:"models"`
-	Aliases              map[string]interface{} `json:"aliases"`
-	Config               map[string]interface{} `json:"config"`
-	UserID               string                 `json:"user_id"`
-	TeamID               *string                `json:"team_id"`
-	MaxParallelRequests  *int                   `json:"max_parallel_requests"`
-	Metadata             map[string]interface{} `json:"metadata"`
-	TPMLimit             *int                   `json:"tpm_limit"`
-	RPMLimit             *int                   `json:"rpm_limit"`
-	BudgetDuration       *string                `json:"budget_duration"`
-	BudgetResetAt        *time.Time             `json:"budget_reset_at"`
-	AllowedCacheControls []string               `json:"allowed_cache_controls"`
-	AllowedRoutes        []string               `json:"allowed_routes"`
-	Permissions          map[string]interface{} `json:"permissions"`
-	ModelSpend           map[string]interface{} `json:"model_spend"`
-	ModelMaxBudget       map[string]interface{} `json:"model_max_budget"`
-	SoftBudgetCooldown   bool                   `json:"soft_budget_cooldown"`
-	Blocked              *bool                  `json:"blocked"`
-	LitellmBudgetTable   interface{}            `json:"litellm_budget_table"`
-	OrgID                *string                `json:"org_id"`
-	CreatedAt            time.Time              `json:"created_at"`
-	CreatedBy            string                 `json:"created_by"`
-	UpdatedAt            time.Time              `json:"updated_at"`
-	UpdatedBy            string                 `json:"updated_by"`
-	ObjectPermissionID   *string                `json:"object_permission_id"`
-	ObjectPermission     interface{}            `json:"object_permission"`
-	TeamSpend            *float64               `json:"team_spend"`
-	TeamAlias            *string                `json:"team_alias"`
-	TeamTPMLimit         *int                   `json:"team_tpm_limit"`
-	TeamRPMLimit         *int                   `json:"team_rpm_limit"`
-	TeamMaxBudget        *float64               `json:"team_max_budget"`
-	TeamModels           []string               `json:"team_models"`
-	TeamBlocked          bool                   `json:"team_blocked"`
-	SoftBudget           *float64               `json:"soft_budget"`
-	TeamModelAliases     interface{}            `json:"team_model_aliases"`
-	TeamMemberSpend      *float64               `json:"team_member_spend"`
-	TeamMember           interface{}            `json:"team_member"`
-	TeamMetadata         interface{}            `json:"team_metadata"`
-	EndUserID            *string                `json:"end_user_id"`
-	EndUserTPMLimit      *int                   `json:"end_user_tpm_limit"`
-	EndUserRPMLimit      *int                   `json:"end_user_rpm_limit"`
-	EndUserMaxBudget     *float64               `json:"end_user_max_budget"`
-	LastRefreshedAt      *time.Time             `json:"last_refreshed_at"`
-	APIKey               *string                `json:"api_key"`
-	UserRole             *string                `json:"user_role"`
-	AllowedModelRegion   *string                `json:"allowed_model_region"`
-	ParentOtelSpan       *string                `json:"parent_otel_span"`
-	RPMLimitPerModel     interface{}            `json:"rpm_limit_per_model"`
-	TPMLimitPerModel     interface{}            `json:"tpm_limit_per_model"`
-	UserTPMLimit         *int                   `json:"user_tpm_limit"`
-	UserRPMLimit         *int                   `json:"user_rpm_limit"`
-	UserEmail            *string                `json:"user_email"`
-	RequestRoute         *string                `json:"request_route"`
+	Token                string                  `json:"token"`
+	KeyName              string                  `json:"key_name"`
+	KeyAlias             *string                 `json:"key_alias"`
+	Spend                float64                 `json:"spend"`
+	MaxBudget            *float64                `json:"max_budget"`
+	Expires              *time.Time              `json:"expires"`
+	Models               []string                `json:"models"`
+	Aliases              map[string]interface{}  `json:"aliases"`
+	Config               map[string]interface{}  `json:"config"`
+	UserID               string                  `json:"user_id"`
+	TeamID               *string                 `json:"team_id"`
+	MaxParallelRequests  *int                    `json:"max_parallel_requests"`
+	Metadata             map[string]interface{}  `json:"metadata"`
+	TPMLimit             *int                    `json:"tpm_limit"`
+	RPMLimit             *int                    `json:"rpm_limit"`
+	BudgetDuration       *string                 `json:"budget_duration"`
+	BudgetResetAt        *time.Time              `json:"budget_reset_at"`
+	AllowedCacheControls []string                `json:"allowed_cache_controls"`
+	AllowedRoutes        []string                `json:"allowed_routes"`
+	Permissions          map[string]interface{}  `json:"permissions"`
+	ModelSpend           map[string]interface{}  `json:"model_spend"`
+	ModelMaxBudget       map[string]interface{}  `json:"model_max_budget"`
+	SoftBudgetCooldown   bool                    `json:"soft_budget_cooldown"`
+	Blocked              *bool                   `json:"blocked"`
+	LitellmBudgetTable   interface{}             `json:"litellm_budget_table"`
+	OrgID                *string                 `json:"org_id"`
+	CreatedAt            time.Time               `json:"created_at"`
+	CreatedBy            string                  `json:"created_by"`
+	UpdatedAt            time.Time               `json:"updated_at"`
+	UpdatedBy            string                  `json:"updated_by"`
+	ObjectPermissionID   *string                 `json:"object_permission_id"`
+	ObjectPermission     *utils.ObjectPermission `json:"object_permission"`
+	TeamSpend            *float64                `json:"team_spend"`
+	TeamAlias            *string                 `json:"team_alias"`
+	TeamTPMLimit         *int                    `json:"team_tpm_limit"`
+	TeamRPMLimit         *int                    `json:"team_rpm_limit"`
+	TeamMaxBudget        *float64                `json:"team_max_budget"`
+	TeamModels           []string                `json:"team_models"`
+	TeamBlocked          bool                    `json:"team_blocked"`
+	SoftBudget           *float64                `json:"soft_budget"`
+	TeamModelAliases     interface{}             `json:"team_model_aliases"`
+	TeamMemberSpend      *float64                `json:"team_member_spend"`
+	TeamMember           interface{}             `json:"team_member"`
+	TeamMetadata         interface{}             `json:"team_metadata"`
+	EndUserID            *string                 `json:"end_user_id"`
+	EndUserTPMLimit      *int                    `json:"end_user_tpm_limit"`
+	EndUserRPMLimit      *int                    `json:"end_user_rpm_limit"`
+	EndUserMaxBudget     *float64                `json:"end_user_max_budget"`
+	LastRefreshedAt      *time.Time              `json:"last_refreshed_at"`
+	APIKey               *string                 `json:"api_key"`
+	UserRole             *string                 `json:"user_role"`
+	AllowedModelRegion   *string                 `json:"allowed_model_region"`
+	ParentOtelSpan       *string                 `json:"parent_otel_span"`
+	RPMLimitPerModel     interface{}             `json:"rpm_limit_per_model"`
+	TPMLimitPerModel     interface{}             `json:"tpm_limit_per_model"`
+	UserTPMLimit         *int                    `json:"user_tpm_limit"`
+	UserRPMLimit         *int                    `json:"user_rpm_limit"`
+	UserEmail            *string                 `json:"user_email"`
+	RequestRoute         *string                 `json:"request_route"`
 }
@@ -25,6 +25,10 @@ func getKey(ctx context.Context, c *litellm.Client, keyID string) (*KeyInfoRespo
 		ctx, c, http.MethodGet, fmt.Sprintf("/key/info?key=%s", url.QueryEscape(keyID)), nil,
 	)
 	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("failed to get key: %w", err)
 	}
 
@@ -65,32 +69,48 @@ func deleteKey(ctx context.Context, c *litellm.Client, keyID string) error {
 	return nil
 }
 
-// listKeys queries the /key/list endpoint to find keys by alias
-func listKeys(ctx context.Context, c *litellm.Client, keyAlias string) (*KeyListResponse, error) {
-	// Build query parameters
-	queryParams := fmt.Sprintf("?page=1&size=10&key_alias=%s&return_full_object=true&include_team_keys=false&sort_order=desc", url.QueryEscape(keyAlias))
-
-	response, err := litellm.SendRequestTyped[interface{}, KeyListResponse](
-		ctx, c, http.MethodGet, "/key/list"+queryParams, nil,
-	)
+// listKeys queries the /key/list endpoint for all keys with the given alias
+func listKeys(ctx context.Context, c *litellm.Client, keyAlias string) ([]KeyListItem, error) {
+	keys, err := litellm.ListAll(ctx, c, litellm.ListRequest{
+		Path: "/key/list",
+		Filters: url.Values{
+			"key_alias":          {keyAlias},
+			"return_full_object": {"true"},
+			"include_team_keys":  {"false"},
+			"sort_order":         {"desc"},
+		},
+	}, func(response *KeyListResponse) ([]KeyListItem, litellm.PageInfo) {
+		return response.Keys, litellm.PageInfo{TotalPages: response.TotalPages}
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list keys: %w", err)
 	}
 
-	return response, nil
+	return keys, nil
 }
 
-// findKeyByAlias finds a key by its alias using the list endpoint
+// findKeyByAlias finds the key with the given alias using the list endpoint.
+// It fails when no key or more than one key has the alias.
 func findKeyByAlias(ctx context.Context, c *litellm.Client, keyAlias string) (*KeyListItem, error) {
-	response, err := listKeys(ctx, c, keyAlias)
+	keys, err := listKeys(ctx, c, keyAlias)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(response.Keys) == 0 {
-		return nil, fmt.Errorf("no key found with alias: %s", keyAlias)
+	// The alias filter may match partially, so only keep exact matches
+	var matches []KeyListItem
+	for _, key := range keys {
+		if key.KeyAlias != nil && *key.KeyAlias == keyAlias {
+			matches = append(matches, key)
+		}
 	}
 
-	// Return the first matching key
-	return &response.Keys[0], nil
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no key found with alias: %s", keyAlias)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d keys found with alias %s, set token_id in the state to pick one", len(matches), keyAlias)
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+	"github.com/scalepad/terraform-provider-litellm/internal/tools/mcp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,7 @@ func ResourceKey() *schema.Resource {
 		ReadContext:   resourceKeyRead,
 		UpdateContext: resourceKeyUpdate,
 		DeleteContext: resourceKeyDelete,
+		CustomizeDiff: mcp.ValidateAccessGroupsDiff("object_permission.0.mcp_access_groups"),
 		Schema:        resourceKeySchema(),
 
 		// State migration configuration
@@ -34,8 +34,9 @@ func resourceKeySchema() map[string]*schema.Schema {
 		},
 		"budget_id": {
 			Type:        schema.TypeString,
+			Optional:    true,
 			Computed:    true,
-			Description: "The budget ID associated with this key.",
+			Description: "The budget ID associated with this key. Set this to attach an existing budget to the key.",
 		},
 
 		// Configuration fields - user configurable
@@ -197,6 +198,34 @@ func resourceKeySchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Description: "Tags associated with this key.",
 		},
+		"object_permission": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Object-level permissions for this key.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vector_stores": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of vector store IDs the key can access.",
+					},
+					"mcp_servers": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of MCP server IDs the key can access.",
+					},
+					"mcp_access_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of MCP access groups the key can access.",
+					},
+				},
+			},
+		},
 		"send_invite_email": {
 			Type:        schema.TypeBool,
 			Optional:    true,
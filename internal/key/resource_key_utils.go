@@ -102,6 +102,11 @@ func buildKeyGenerateRequest(d *schema.ResourceData) *KeyGenerateRequest {
 		request.EnforcedParams = v.(map[string]interface{})
 	}
 
+	// Nested blocks
+	if v, ok := d.GetOk("object_permission"); ok {
+		request.ObjectPermission = utils.ExpandObjectPermission(v.([]interface{}))
+	}
+
 	return request
 }
 
@@ -253,6 +258,15 @@ func buildKeyUpdateRequest(d *schema.ResourceData) *KeyGenerateRequest {
 		}
 	}
 
+	// Nested blocks - only include if changed, an empty permission clears it
+	if d.HasChange("object_permission") {
+		permission := utils.ExpandObjectPermission(d.Get("object_permission").([]interface{}))
+		if permission == nil {
+			permission = utils.NewObjectPermission()
+		}
+		request.ObjectPermission = permission
+	}
+
 	return request
 }
 
@@ -363,6 +377,11 @@ func setKeyResourceDataFromInfo(d *schema.ResourceData, response *KeyInfoRespons
 		"updated_at":             formatTime(info.UpdatedAt),
 	}
 
+	// Object permissions are returned as a nested object; an empty one is kept out of state
+	if permission := utils.FlattenObjectPermission(info.ObjectPermission); len(permission) > 0 {
+		apiFields["object_permission"] = permission
+	}
+
 	// Fields that should never be overridden from API (preserve state)
 	onlyCreationFields := []string{
 		"key",      // Sensitive - only available during creation
@@ -41,6 +41,10 @@ func GetServiceAccount(ctx context.Context, c *litellm.Client, keyID string) (*S
 		ctx, c, http.MethodGet, fmt.Sprintf("/key/info?key=%s", keyID), nil,
 	)
 	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("failed to get service account: %w", err)
 	}
 
@@ -7,8 +7,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/http/httptrace"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -16,6 +22,8 @@ import (
 type Client struct {
 	APIBase        string
 	APIKey         string
+	MaxRetries     int           // Number of times a failed request is retried
+	RetryMaxWait   time.Duration // Longest wait between two attempts
 	httpClient     *http.Client
 	rateLimitedMux sync.Mutex
 }
@@ -31,140 +39,275 @@ func NewClient(apiBase, apiKey string, insecureSkipVerify bool) *Client {
 	}
 
 	return &Client{
-		APIBase:    apiBase,
-		APIKey:     apiKey,
-		httpClient: &http.Client{Transport: tr},
+		APIBase:      apiBase,
+		APIKey:       apiKey,
+		MaxRetries:   DefaultMaxRetries,
+		RetryMaxWait: DefaultRetryMaxWait,
+		httpClient:   &http.Client{Transport: tr},
 	}
 }
 
+// apiResponse is the part of an HTTP response the client works with
+type apiResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// doRequest sends a request with the given body and returns the response.
+// Failed attempts are retried according to the client's retry policy, see shouldRetry.
+func (c *Client) doRequest(ctx context.Context, method, url string, body []byte, contentType string) (*apiResponse, error) {
+	for attempt := 0; ; attempt++ {
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+
+		// Track whether the request reached the server, so non-idempotent requests are only retried when it did not
+		var sent atomic.Bool
+		traceCtx := httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			WroteHeaders: func() { sent.Store(true) },
+		})
+
+		req, err := http.NewRequestWithContext(traceCtx, method, url, bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("error creating request: %v", err)
+		}
+
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("x-api-key", c.APIKey)
+		req.Header.Set("accept", "application/json")
+
+		resp, retryAfter, err := c.roundTrip(req)
+
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+
+		if attempt >= c.MaxRetries || !shouldRetry(method, statusCode, err, sent.Load()) || ctx.Err() != nil {
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+
+		delay := retryDelay(attempt, retryAfter, c.RetryMaxWait)
+		tflog.Warn(ctx, "Retrying request", map[string]interface{}{
+			"method":      method,
+			"url":         url,
+			"attempt":     attempt + 1,
+			"status_code": statusCode,
+			"error":       fmt.Sprint(err),
+			"delay":       delay.String(),
+		})
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, fmt.Errorf("error making request: %v", err)
+		}
+	}
+}
+
+// roundTrip performs a single attempt and returns the response and its Retry-After header
+func (c *Client) roundTrip(req *http.Request) (*apiResponse, string, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading response body: %v", err)
+	}
+
+	return &apiResponse{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        bodyBytes,
+	}, resp.Header.Get("Retry-After"), nil
+}
+
+// decodeResponse turns a response into a TResponse. Every 2xx status is a success; other statuses return an *APIError.
+// Empty and null bodies decode to the zero value. Bodies that are not JSON are returned as-is when TResponse is a string.
+func decodeResponse[TResponse any](method, path string, resp *apiResponse) (*TResponse, error) {
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newAPIError(method, path, resp.StatusCode, resp.Body)
+	}
+
+	var result TResponse
+	trimmed := bytes.TrimSpace(resp.Body)
+	if len(trimmed) == 0 || string(trimmed) == "null" {
+		return &result, nil
+	}
+
+	if !isJSONContentType(resp.ContentType) {
+		if text, ok := any(&result).(*string); ok {
+			*text = string(resp.Body)
+			return &result, nil
+		}
+	}
+
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing response JSON: %v\nResponse body: %s", err, string(resp.Body))
+	}
+
+	return &result, nil
+}
+
+// isJSONContentType reports whether a Content-Type header denotes JSON. A missing header is treated as JSON.
+func isJSONContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
 // SendRequest sends an HTTP request to the LiteLLM API and returns the response as a map.
 func (c *Client) SendRequest(ctx context.Context, method, path string, body interface{}) (map[string]interface{}, error) {
+	ctx = c.maskedLogContext(ctx)
 	url := c.APIBase + path
 
-	var req *http.Request
-	var err error
-
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling request body: %v", err)
 		}
 		tflog.Debug(ctx, "Making request with body", map[string]interface{}{
 			"method": method,
 			"url":    url,
-			"body":   string(jsonBody),
+			"body":   redactBody(jsonBody),
 		})
-		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
 	} else {
 		tflog.Debug(ctx, "Making request", map[string]interface{}{
 			"method": method,
 			"url":    url,
 		})
-		req, err = http.NewRequestWithContext(ctx, method, url, nil)
 	}
 
+	resp, err := c.doRequest(ctx, method, url, jsonBody, "application/json")
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", c.APIKey)
-	req.Header.Set("accept", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
+		return nil, err
 	}
 
 	tflog.Debug(ctx, "Received response", map[string]interface{}{
 		"status_code": resp.StatusCode,
-		"body":        string(bodyBytes),
+		"body":        redactBody(resp.Body),
 	})
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code %d: %s", resp.StatusCode, string(bodyBytes))
+	result, err := decodeResponse[map[string]interface{}](method, path, resp)
+	if err != nil {
+		return nil, err
 	}
 
-	var result map[string]interface{}
-	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		if method == "POST" && (len(bodyBytes) == 0 || string(bodyBytes) == "null") {
-			return make(map[string]interface{}), nil
-		}
-		return nil, fmt.Errorf("error parsing response JSON: %v\nResponse body: %s", err, string(bodyBytes))
+	if *result == nil {
+		return make(map[string]interface{}), nil
 	}
 
-	return result, nil
+	return *result, nil
 }
 
 // SendRequestTyped sends an HTTP request to the LiteLLM API with typed request and response.
 func SendRequestTyped[TRequest any, TResponse any](ctx context.Context, c *Client, method, path string, body *TRequest) (*TResponse, error) {
+	ctx = c.maskedLogContext(ctx)
 	url := c.APIBase + path
 
-	var req *http.Request
-	var err error
-
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling request body: %v", err)
 		}
 		tflog.Debug(ctx, "Making typed request with body", map[string]interface{}{
 			"method": method,
 			"url":    url,
-			"body":   string(jsonBody),
+			"body":   redactBody(jsonBody),
 		})
-		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
 	} else {
 		tflog.Debug(ctx, "Making typed request", map[string]interface{}{
 			"method": method,
 			"url":    url,
 		})
-		req, err = http.NewRequestWithContext(ctx, method, url, nil)
 	}
 
+	resp, err := c.doRequest(ctx, method, url, jsonBody, "application/json")
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", c.APIKey)
-	req.Header.Set("accept", "application/json")
+	tflog.Debug(ctx, "Received typed response", map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"body":        redactBody(resp.Body),
+	})
+
+	return decodeResponse[TResponse](method, path, resp)
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+// MultipartFile is a file part of a multipart/form-data request.
+type MultipartFile struct {
+	FieldName string
+	FileName  string
+	Content   io.Reader
+}
+
+// SendMultipartRequestTyped sends a multipart/form-data request to the LiteLLM API with a typed response.
+// It is used by endpoints that take file uploads instead of a JSON body.
+func SendMultipartRequestTyped[TResponse any](ctx context.Context, c *Client, method, path string, fields map[string]string, files []MultipartFile) (*TResponse, error) {
+	ctx = c.maskedLogContext(ctx)
+	url := c.APIBase + path
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return nil, fmt.Errorf("error writing multipart field %s: %v", name, err)
+		}
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
+	fileNames := make([]string, 0, len(files))
+	for _, file := range files {
+		part, err := writer.CreateFormFile(file.FieldName, file.FileName)
+		if err != nil {
+			return nil, fmt.Errorf("error creating multipart file %s: %v", file.FileName, err)
+		}
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return nil, fmt.Errorf("error writing multipart file %s: %v", file.FileName, err)
+		}
+		fileNames = append(fileNames, file.FileName)
 	}
 
-	tflog.Debug(ctx, "Received typed response", map[string]interface{}{
-		"status_code": resp.StatusCode,
-		"body":        string(bodyBytes),
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error closing multipart body: %v", err)
+	}
+
+	// File contents are not logged, only their names
+	tflog.Debug(ctx, "Making multipart request", map[string]interface{}{
+		"method": method,
+		"url":    url,
+		"files":  fileNames,
 	})
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code %d: %s", resp.StatusCode, string(bodyBytes))
+	resp, err := c.doRequest(ctx, method, url, buf.Bytes(), writer.FormDataContentType())
+	if err != nil {
+		return nil, err
 	}
 
-	var result TResponse
-	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		if method == "POST" && (len(bodyBytes) == 0 || string(bodyBytes) == "null") {
-			return &result, nil
-		}
-		return nil, fmt.Errorf("error parsing response JSON: %v\nResponse body: %s", err, string(bodyBytes))
-	}
+	tflog.Debug(ctx, "Received multipart response", map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"body":        redactBody(resp.Body),
+	})
 
-	return &result, nil
+	return decodeResponse[TResponse](method, path, resp)
 }
 
 // SendRequestTypedRateLimited sends an HTTP request to the LiteLLM API with typed request and response,
@@ -0,0 +1,117 @@
+package litellm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		response    *apiResponse
+		expected    map[string]interface{}
+		expectError bool
+	}{
+		{
+			name:     "200 with body",
+			method:   http.MethodGet,
+			response: &apiResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: []byte(`{"id": "abc"}`)},
+			expected: map[string]interface{}{"id": "abc"},
+		},
+		{
+			name:     "201 created",
+			method:   http.MethodPost,
+			response: &apiResponse{StatusCode: http.StatusCreated, ContentType: "application/json; charset=utf-8", Body: []byte(`{"id": "abc"}`)},
+			expected: map[string]interface{}{"id": "abc"},
+		},
+		{
+			name:     "204 no content on DELETE",
+			method:   http.MethodDelete,
+			response: &apiResponse{StatusCode: http.StatusNoContent},
+			expected: nil,
+		},
+		{
+			name:     "null body on GET",
+			method:   http.MethodGet,
+			response: &apiResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: []byte("null\n")},
+			expected: nil,
+		},
+		{
+			name:     "JSON sent as text",
+			method:   http.MethodGet,
+			response: &apiResponse{StatusCode: http.StatusOK, ContentType: "text/plain; charset=utf-8", Body: []byte(`{"id": "abc"}`)},
+			expected: map[string]interface{}{"id": "abc"},
+		},
+		{
+			name:        "invalid JSON",
+			method:      http.MethodGet,
+			response:    &apiResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: []byte("<html>")},
+			expectError: true,
+		},
+		{
+			name:        "error status",
+			method:      http.MethodGet,
+			response:    &apiResponse{StatusCode: http.StatusBadRequest, ContentType: "application/json", Body: []byte(`{"detail": "bad"}`)},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := decodeResponse[map[string]interface{}](tt.method, "/test", tt.response)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("decodeResponse() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("decodeResponse() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(*result, tt.expected) {
+				t.Errorf("decodeResponse() = %v, want %v", *result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSendRequestTypedTextResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("I'm alive!"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key", false)
+
+	result, err := SendRequestTyped[interface{}, string](context.Background(), client, http.MethodGet, "/health/liveliness", nil)
+	if err != nil {
+		t.Fatalf("SendRequestTyped() unexpected error: %v", err)
+	}
+	if *result != "I'm alive!" {
+		t.Errorf("SendRequestTyped() = %q, want %q", *result, "I'm alive!")
+	}
+}
+
+func TestSendRequestEmptyDeleteResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key", false)
+
+	result, err := client.SendRequest(context.Background(), http.MethodDelete, "/vector_store/abc", nil)
+	if err != nil {
+		t.Fatalf("SendRequest() unexpected error: %v", err)
+	}
+	if result == nil || len(result) != 0 {
+		t.Errorf("SendRequest() = %v, want an empty map", result)
+	}
+}
@@ -0,0 +1,108 @@
+package litellm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when the LiteLLM API responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int    // HTTP status code of the response
+	Method     string // HTTP method of the request
+	Path       string // Request path, including the query string
+	Message    string // Error message parsed from the response body
+	Body       string // Raw response body
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request %s %s failed with status code %d: %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// newAPIError builds an APIError from a response, parsing the message from the ErrorResponse body
+func newAPIError(method, path string, statusCode int, body []byte) *APIError {
+	return &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       path,
+		Message:    parseErrorMessage(body),
+		Body:       string(body),
+	}
+}
+
+// parseErrorMessage extracts the error message of a LiteLLM error response. It falls back to the raw body.
+func parseErrorMessage(body []byte) string {
+	var response ErrorResponse
+	if err := json.Unmarshal(body, &response); err == nil {
+		if message := messageString(response.Error.Message); message != "" {
+			return message
+		}
+
+		switch detail := response.Detail.(type) {
+		case string:
+			if detail != "" {
+				return detail
+			}
+		case map[string]interface{}:
+			if message := messageString(detail["error"]); message != "" {
+				return message
+			}
+			if message := messageString(detail); message != "" {
+				return message
+			}
+		case nil:
+		default:
+			if message := messageString(detail); message != "" {
+				return message
+			}
+		}
+	}
+
+	return strings.TrimSpace(string(body))
+}
+
+// messageString converts a message field of unknown shape into a string
+func messageString(v interface{}) string {
+	switch m := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return m
+	default:
+		encoded, err := json.Marshal(m)
+		if err != nil {
+			return fmt.Sprint(m)
+		}
+		return string(encoded)
+	}
+}
+
+// IsStatus reports whether err is an APIError with the given status code
+func IsStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is an APIError with status 404
+func IsNotFound(err error) bool {
+	return IsStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError with status 409
+func IsConflict(err error) bool {
+	return IsStatus(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is an APIError with status 401 or 403
+func IsUnauthorized(err error) bool {
+	return IsStatus(err, http.StatusUnauthorized) || IsStatus(err, http.StatusForbidden)
+}
+
+// MessageContains reports whether err is an APIError whose parsed message contains substr.
+// It is meant for the few endpoints that report a missing object without a 404 status code.
+func MessageContains(err error, substr string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && strings.Contains(apiErr.Message, substr)
+}
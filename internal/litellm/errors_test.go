@@ -0,0 +1,125 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseErrorMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "error message",
+			body:     `{"error": {"message": "Team not found", "type": "not_found", "code": "404"}}`,
+			expected: "Team not found",
+		},
+		{
+			name:     "detail string",
+			body:     `{"detail": "Not authorized"}`,
+			expected: "Not authorized",
+		},
+		{
+			name:     "detail error object",
+			body:     `{"detail": {"error": "Customer does not exist"}}`,
+			expected: "Customer does not exist",
+		},
+		{
+			name:     "detail object without error",
+			body:     `{"detail": {"message": "Invalid model"}}`,
+			expected: `{"message":"Invalid model"}`,
+		},
+		{
+			name:     "plain text",
+			body:     "Internal Server Error\n",
+			expected: "Internal Server Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := parseErrorMessage([]byte(tt.body)); result != tt.expected {
+				t.Errorf("parseErrorMessage() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		conflict     bool
+		unauthorized bool
+	}{
+		{
+			name:     "not found",
+			err:      newAPIError(http.MethodGet, "/team/info", http.StatusNotFound, []byte(`{"detail": "Team not found"}`)),
+			notFound: true,
+		},
+		{
+			name:     "wrapped not found",
+			err:      fmt.Errorf("failed to get team: %w", newAPIError(http.MethodGet, "/team/info", http.StatusNotFound, nil)),
+			notFound: true,
+		},
+		{
+			name:     "not found in message only",
+			err:      newAPIError(http.MethodGet, "/model/info?litellm_model_id=not-found-proxy", http.StatusInternalServerError, []byte(`{"error": {"message": "model not found-proxy failed"}}`)),
+			notFound: false,
+		},
+		{
+			name:     "conflict",
+			err:      newAPIError(http.MethodPost, "/team/new", http.StatusConflict, nil),
+			conflict: true,
+		},
+		{
+			name:         "forbidden",
+			err:          newAPIError(http.MethodPost, "/team/new", http.StatusForbidden, nil),
+			unauthorized: true,
+		},
+		{
+			name: "not an API error",
+			err:  fmt.Errorf("error making request: connection refused 404"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IsNotFound(tt.err); result != tt.notFound {
+				t.Errorf("IsNotFound() = %v, want %v", result, tt.notFound)
+			}
+			if result := IsConflict(tt.err); result != tt.conflict {
+				t.Errorf("IsConflict() = %v, want %v", result, tt.conflict)
+			}
+			if result := IsUnauthorized(tt.err); result != tt.unauthorized {
+				t.Errorf("IsUnauthorized() = %v, want %v", result, tt.unauthorized)
+			}
+		})
+	}
+}
+
+func TestSendRequestTypedReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"detail": {"error": "Team not found"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key", false)
+
+	_, err := SendRequestTyped[interface{}, map[string]interface{}](context.Background(), client, http.MethodGet, "/team/info?team_id=abc", nil)
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("SendRequestTyped() error = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Path != "/team/info?team_id=abc" || apiErr.Message != "Team not found" {
+		t.Errorf("SendRequestTyped() error = %+v", apiErr)
+	}
+}
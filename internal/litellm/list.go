@@ -0,0 +1,119 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// defaultListPageSize is the page size used when a ListRequest does not set one
+	defaultListPageSize = 100
+	// maxListPages stops a listing whose envelope never signals the last page
+	maxListPages = 1000
+)
+
+// ListRequest describes a paginated GET list endpoint.
+type ListRequest struct {
+	Path        string     // Endpoint path without query string, e.g. /key/list
+	Filters     url.Values // Server-side filters sent with every page request
+	PageSize    int        // Items per page, defaults to 100
+	PageParam   string     // Query parameter of the page number, defaults to "page"
+	SizeParam   string     // Query parameter of the page size, defaults to "size"
+	CursorParam string     // Query parameter of the cursor, defaults to "cursor"
+}
+
+// PageInfo is the pagination part of a list response envelope.
+// Page-based envelopes set TotalPages, cursor-based envelopes set NextCursor.
+type PageInfo struct {
+	TotalPages int
+	NextCursor string
+}
+
+// PageFunc extracts the items and pagination info from a decoded list response
+type PageFunc[TResponse any, T any] func(*TResponse) ([]T, PageInfo)
+
+// List returns an iterator over all items of a paginated list endpoint. Pages are requested lazily,
+// so stopping the iteration early skips the remaining requests. An error ends the iteration.
+func List[TResponse any, T any](ctx context.Context, c *Client, request ListRequest, page PageFunc[TResponse, T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		pageSize := request.PageSize
+		if pageSize <= 0 {
+			pageSize = defaultListPageSize
+		}
+
+		cursor := ""
+		for pageNumber := 1; pageNumber <= maxListPages; pageNumber++ {
+			path := request.pagePath(pageNumber, pageSize, cursor)
+
+			response, err := SendRequestTyped[interface{}, TResponse](ctx, c, http.MethodGet, path, nil)
+			if err != nil {
+				var zero T
+				yield(zero, fmt.Errorf("failed to list %s: %w", request.Path, err))
+				return
+			}
+
+			items, info := page(response)
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			switch {
+			case len(items) == 0:
+				return
+			case info.NextCursor != "":
+				cursor = info.NextCursor
+			case cursor != "":
+				// A cursor-based listing without a next cursor is complete
+				return
+			case info.TotalPages > 0 && pageNumber >= info.TotalPages:
+				return
+			case info.TotalPages == 0 && len(items) < pageSize:
+				return
+			}
+		}
+	}
+}
+
+// ListAll collects all items of a paginated list endpoint, see List.
+func ListAll[TResponse any, T any](ctx context.Context, c *Client, request ListRequest, page PageFunc[TResponse, T]) ([]T, error) {
+	var all []T
+	for item, err := range List(ctx, c, request, page) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+
+	return all, nil
+}
+
+// pagePath builds the request path of a single page
+func (r ListRequest) pagePath(pageNumber, pageSize int, cursor string) string {
+	query := url.Values{}
+	for k, v := range r.Filters {
+		query[k] = v
+	}
+
+	query.Set(paramOrDefault(r.SizeParam, "size"), strconv.Itoa(pageSize))
+	if cursor != "" {
+		query.Set(paramOrDefault(r.CursorParam, "cursor"), cursor)
+	} else {
+		query.Set(paramOrDefault(r.PageParam, "page"), strconv.Itoa(pageNumber))
+	}
+
+	return r.Path + "?" + query.Encode()
+}
+
+// paramOrDefault returns param, or fallback when param is empty
+func paramOrDefault(param, fallback string) string {
+	if param == "" {
+		return fallback
+	}
+	return param
+}
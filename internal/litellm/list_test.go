@@ -0,0 +1,156 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type testPageResponse struct {
+	Items      []int `json:"items"`
+	TotalPages int   `json:"total_pages"`
+}
+
+type testCursorResponse struct {
+	Teams      []int  `json:"teams"`
+	NextCursor string `json:"next_cursor"`
+}
+
+func TestListAllPages(t *testing.T) {
+	tests := []struct {
+		name          string
+		totalItems    int
+		pageSize      int
+		reportTotal   bool
+		expectedPages int
+	}{
+		{name: "single page", totalItems: 3, pageSize: 5, reportTotal: true, expectedPages: 1},
+		{name: "total pages", totalItems: 7, pageSize: 3, reportTotal: true, expectedPages: 3},
+		{name: "short last page", totalItems: 7, pageSize: 3, reportTotal: false, expectedPages: 3},
+		{name: "empty last page", totalItems: 6, pageSize: 3, reportTotal: false, expectedPages: 3},
+		{name: "no items", totalItems: 0, pageSize: 3, reportTotal: true, expectedPages: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				pages++
+				if got := r.URL.Query().Get("user_id"); got != "u1" {
+					t.Errorf("filter user_id = %q, want %q", got, "u1")
+				}
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+
+				response := testPageResponse{Items: []int{}}
+				for i := (page - 1) * size; i < page*size && i < tt.totalItems; i++ {
+					response.Items = append(response.Items, i)
+				}
+				if tt.reportTotal {
+					response.TotalPages = (tt.totalItems + size - 1) / size
+				}
+				json.NewEncoder(w).Encode(response)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-key", false)
+			items, err := ListAll(context.Background(), client, ListRequest{
+				Path:     "/test/list",
+				Filters:  map[string][]string{"user_id": {"u1"}},
+				PageSize: tt.pageSize,
+			}, func(response *testPageResponse) ([]int, PageInfo) {
+				return response.Items, PageInfo{TotalPages: response.TotalPages}
+			})
+			if err != nil {
+				t.Fatalf("ListAll() unexpected error: %v", err)
+			}
+
+			var expected []int
+			for i := 0; i < tt.totalItems; i++ {
+				expected = append(expected, i)
+			}
+			if !reflect.DeepEqual(items, expected) {
+				t.Errorf("ListAll() = %v, want %v", items, expected)
+			}
+			if pages != tt.expectedPages {
+				t.Errorf("server saw %d page requests, want %d", pages, tt.expectedPages)
+			}
+		})
+	}
+}
+
+func TestListAllCursor(t *testing.T) {
+	cursors := map[string]testCursorResponse{
+		"":   {Teams: []int{1, 2}, NextCursor: "c2"},
+		"c2": {Teams: []int{3, 4}, NextCursor: "c3"},
+		"c3": {Teams: []int{5}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		if cursor != "" && r.URL.Query().Has("page") {
+			t.Errorf("request with cursor %q also sent page", cursor)
+		}
+		json.NewEncoder(w).Encode(cursors[cursor])
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key", false)
+	items, err := ListAll(context.Background(), client, ListRequest{Path: "/v2/team/list", PageSize: 2},
+		func(response *testCursorResponse) ([]int, PageInfo) {
+			return response.Teams, PageInfo{NextCursor: response.NextCursor}
+		})
+	if err != nil {
+		t.Fatalf("ListAll() unexpected error: %v", err)
+	}
+	if expected := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("ListAll() = %v, want %v", items, expected)
+	}
+}
+
+func TestListStopsEarly(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		json.NewEncoder(w).Encode(testPageResponse{Items: []int{1, 2}, TotalPages: 5})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key", false)
+	for item, err := range List(context.Background(), client, ListRequest{Path: "/test/list", PageSize: 2},
+		func(response *testPageResponse) ([]int, PageInfo) {
+			return response.Items, PageInfo{TotalPages: response.TotalPages}
+		}) {
+		if err != nil {
+			t.Fatalf("List() unexpected error: %v", err)
+		}
+		if item == 1 {
+			break
+		}
+	}
+
+	if pages != 1 {
+		t.Errorf("server saw %d page requests, want 1", pages)
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"detail": "invalid key"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key", false)
+	_, err := ListAll(context.Background(), client, ListRequest{Path: "/test/list"},
+		func(response *testPageResponse) ([]int, PageInfo) {
+			return response.Items, PageInfo{TotalPages: response.TotalPages}
+		})
+	if !IsUnauthorized(err) {
+		t.Errorf("ListAll() error = %v, want unauthorized API error", err)
+	}
+}
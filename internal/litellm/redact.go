@@ -0,0 +1,116 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedValue replaces masked values, matching the replacement used by tflog masking
+const redactedValue = "***"
+
+// sensitiveFields are JSON field names whose values are never logged, compared case-insensitively.
+// Values of nested objects and arrays below sensitive fields are masked as a whole.
+var sensitiveFields = map[string]bool{
+	"key":                true,
+	"token":              true,
+	"x-api-key":          true,
+	"authorization":      true,
+	"aws_access_key_id":  true,
+	"vertex_credentials": true,
+	"env":                true,
+	"headers":            true,
+	"callback_vars":      true,
+}
+
+// sensitiveFieldParts are substrings that mark a JSON field name as sensitive, e.g. google_client_secret
+var sensitiveFieldParts = []string{"secret", "password", "credential"}
+
+// sensitiveFieldSuffixes are suffixes that mark a JSON field name as sensitive, e.g. langfuse_public_key
+var sensitiveFieldSuffixes = []string{"_key", "_token"}
+
+// identifierFieldSuffixes are suffixes of fields that name or identify a secret without holding it,
+// e.g. credential_name. They are checked after sensitiveFields.
+var identifierFieldSuffixes = []string{"_name", "_alias", "_id"}
+
+// secretTokenRegex matches LiteLLM virtual keys and OpenAI style sk- tokens anywhere in a log field
+var secretTokenRegex = regexp.MustCompile(`sk-[A-Za-z0-9_\-]{4,}`)
+
+// maskedLogContext returns a context whose tflog output masks the API key header, the configured
+// master key and sk- tokens in all log fields.
+func (c *Client) maskedLogContext(ctx context.Context) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "x-api-key", "api_key", "authorization")
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, secretTokenRegex)
+	if c.APIKey != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, c.APIKey)
+	}
+
+	return ctx
+}
+
+// redactBody returns a request or response body for logging with the values of sensitive JSON fields masked.
+// Bodies that are not JSON are returned unchanged and rely on the tflog masks of maskedLogContext.
+func redactBody(body []byte) string {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(data))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+// redactValue masks the values of sensitive fields in a decoded JSON value
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if fieldValue != nil && isSensitiveField(field) {
+				v[field] = redactedValue
+				continue
+			}
+			v[field] = redactValue(fieldValue)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// isSensitiveField reports whether the value of a JSON field must not be logged
+func isSensitiveField(field string) bool {
+	field = strings.ToLower(field)
+	if sensitiveFields[field] {
+		return true
+	}
+
+	for _, suffix := range identifierFieldSuffixes {
+		if strings.HasSuffix(field, suffix) {
+			return false
+		}
+	}
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(field, part) {
+			return true
+		}
+	}
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(field, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package litellm
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "sensitive top-level fields",
+			body:     `{"key":"sk-abc","key_alias":"ci","models":["gpt-4"],"x-api-key":"abc"}`,
+			expected: `{"key":"***","key_alias":"ci","models":["gpt-4"],"x-api-key":"***"}`,
+		},
+		{
+			name:     "nested litellm params",
+			body:     `{"litellm_params":{"model":"bedrock/claude","aws_secret_access_key":"secret","AWS_ACCESS_KEY_ID":"AKIA"}}`,
+			expected: `{"litellm_params":{"AWS_ACCESS_KEY_ID":"***","aws_secret_access_key":"***","model":"bedrock/claude"}}`,
+		},
+		{
+			name:     "object values are masked as a whole",
+			body:     `{"credential_name":"openai","credential_values":{"api_key":"sk-abc","api_base":"https://api.openai.com"}}`,
+			expected: `{"credential_name":"openai","credential_values":"***"}`,
+		},
+		{
+			name:     "arrays of objects",
+			body:     `[{"server_name":"github","env":{"GITHUB_TOKEN":"ghp_x"}}]`,
+			expected: `[{"env":"***","server_name":"github"}]`,
+		},
+		{
+			name:     "sso settings",
+			body:     `{"google_client_id":"123.apps.googleusercontent.com","google_client_secret":"g-secret","microsoft_client_secret":"m-secret","generic_client_secret":"o-secret","proxy_base_url":"https://proxy.example.com"}`,
+			expected: `{"generic_client_secret":"***","google_client_id":"123.apps.googleusercontent.com","google_client_secret":"***","microsoft_client_secret":"***","proxy_base_url":"https://proxy.example.com"}`,
+		},
+		{
+			name:     "callback settings",
+			body:     `{"team_id":"team-1","callback_name":"langfuse","callback_type":"success","callback_vars":{"langfuse_host":"https://cloud.langfuse.com","langfuse_public_key":"pk-lf","langfuse_secret_key":"sk-lf"}}`,
+			expected: `{"callback_name":"langfuse","callback_type":"success","callback_vars":"***","team_id":"team-1"}`,
+		},
+		{
+			name:     "callback settings in team metadata",
+			body:     `{"metadata":{"callback_settings":{"callbacks":["langfuse"],"langfuse_public_key":"pk-lf","langfuse_secret_key":"sk-lf"}}}`,
+			expected: `{"metadata":{"callback_settings":{"callbacks":["langfuse"],"langfuse_public_key":"***","langfuse_secret_key":"***"}}}`,
+		},
+		{
+			name:     "pass-through headers",
+			body:     `{"path":"/search","target":"https://search.example.com","headers":{"X-Search-Api-Key":"abc"},"max_tokens":100}`,
+			expected: `{"headers":"***","max_tokens":100,"path":"/search","target":"https://search.example.com"}`,
+		},
+		{
+			name:     "null values are kept",
+			body:     `{"token":null}`,
+			expected: `{"token":null}`,
+		},
+		{
+			name:     "non-JSON body is unchanged",
+			body:     `I'm alive!`,
+			expected: `I'm alive!`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := redactBody([]byte(tt.body)); result != tt.expected {
+				t.Errorf("redactBody() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSendRequestRedactsLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key":"sk-generated1234","key_name":"sk-...1234","info":"created sk-generated1234"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := NewClient(server.URL, "master-secret-key", false)
+	body := map[string]interface{}{
+		"model_api_key": "sk-upstream5678",
+		"metadata":      map[string]interface{}{"note": "uses master-secret-key and sk-upstream5678"},
+	}
+	if _, err := client.SendRequest(ctx, http.MethodPost, "/key/generate", body); err != nil {
+		t.Fatalf("SendRequest() unexpected error: %v", err)
+	}
+
+	logs := output.String()
+	for _, secret := range []string{"sk-generated1234", "sk-upstream5678", "master-secret-key"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("log output contains secret %q:\n%s", secret, logs)
+		}
+	}
+	if !strings.Contains(logs, "/key/generate") {
+		t.Errorf("log output is missing the request URL:\n%s", logs)
+	}
+}
@@ -0,0 +1,95 @@
+package litellm
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries used when the provider does not configure max_retries
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is the longest wait between two attempts when the provider does not configure retry_max_wait
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// retryBaseDelay is the wait before the first retry, doubled on every further attempt
+var retryBaseDelay = 500 * time.Millisecond
+
+// retryableStatusCodes are the transient responses a GET request is retried on
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// shouldRetry reports whether a request is retried after an attempt.
+// GET requests are retried on transport errors and transient status codes. Other methods are not
+// idempotent and are only retried when the connection failed before the request was sent.
+func shouldRetry(method string, statusCode int, err error, sent bool) bool {
+	if method == http.MethodGet {
+		return err != nil || retryableStatusCodes[statusCode]
+	}
+
+	return err != nil && !sent
+}
+
+// retryDelay returns the wait before the next attempt. A Retry-After header takes precedence over
+// the jittered exponential backoff. The delay never exceeds maxWait.
+func retryDelay(attempt int, retryAfter string, maxWait time.Duration) time.Duration {
+	delay, ok := parseRetryAfter(retryAfter)
+	if !ok {
+		backoff := retryBaseDelay << attempt
+		if backoff <= 0 || backoff > maxWait {
+			backoff = maxWait
+		}
+		// Equal jitter: wait at least half the backoff so retries from parallel resources spread out
+		delay = backoff/2 + rand.N(backoff/2+1)
+	}
+
+	if delay > maxWait {
+		delay = maxWait
+	}
+
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
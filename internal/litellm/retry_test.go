@@ -0,0 +1,158 @@
+package litellm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	connErr := errors.New("connection refused")
+
+	tests := []struct {
+		name       string
+		method     string
+		statusCode int
+		err        error
+		sent       bool
+		expected   bool
+	}{
+		{name: "GET service unavailable", method: http.MethodGet, statusCode: http.StatusServiceUnavailable, expected: true},
+		{name: "GET too many requests", method: http.MethodGet, statusCode: http.StatusTooManyRequests, expected: true},
+		{name: "GET connection error after send", method: http.MethodGet, err: connErr, sent: true, expected: true},
+		{name: "GET not found", method: http.MethodGet, statusCode: http.StatusNotFound, expected: false},
+		{name: "GET success", method: http.MethodGet, statusCode: http.StatusOK, expected: false},
+		{name: "POST connection error before send", method: http.MethodPost, err: connErr, sent: false, expected: true},
+		{name: "POST connection error after send", method: http.MethodPost, err: connErr, sent: true, expected: false},
+		{name: "POST service unavailable", method: http.MethodPost, statusCode: http.StatusServiceUnavailable, sent: true, expected: false},
+		{name: "DELETE connection error before send", method: http.MethodDelete, err: connErr, sent: false, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := shouldRetry(tt.method, tt.statusCode, tt.err, tt.sent); result != tt.expected {
+				t.Errorf("shouldRetry() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		maxWait    time.Duration
+		min        time.Duration
+		max        time.Duration
+	}{
+		{name: "first backoff", attempt: 0, maxWait: time.Minute, min: 250 * time.Millisecond, max: 500 * time.Millisecond},
+		{name: "third backoff", attempt: 2, maxWait: time.Minute, min: time.Second, max: 2 * time.Second},
+		{name: "backoff capped", attempt: 20, maxWait: 4 * time.Second, min: 2 * time.Second, max: 4 * time.Second},
+		{name: "retry after seconds", attempt: 0, retryAfter: "7", maxWait: time.Minute, min: 7 * time.Second, max: 7 * time.Second},
+		{name: "retry after capped", attempt: 0, retryAfter: "120", maxWait: 10 * time.Second, min: 10 * time.Second, max: 10 * time.Second},
+		{name: "invalid retry after", attempt: 0, retryAfter: "soon", maxWait: time.Minute, min: 250 * time.Millisecond, max: 500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay := retryDelay(tt.attempt, tt.retryAfter, tt.maxWait)
+			if delay < tt.min || delay > tt.max {
+				t.Errorf("retryDelay() = %v, want between %v and %v", delay, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfterDate(t *testing.T) {
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+
+	delay, ok := parseRetryAfter(date)
+	if !ok {
+		t.Fatalf("parseRetryAfter(%q) was not parsed", date)
+	}
+	if delay <= 25*time.Second || delay > 30*time.Second {
+		t.Errorf("parseRetryAfter(%q) = %v, want about 30s", date, delay)
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = 500 * time.Millisecond }()
+
+	tests := []struct {
+		name             string
+		method           string
+		failures         int32
+		expectedAttempts int32
+		expectError      bool
+	}{
+		{name: "GET recovers", method: http.MethodGet, failures: 2, expectedAttempts: 3},
+		{name: "GET gives up", method: http.MethodGet, failures: 10, expectedAttempts: 4, expectError: true},
+		{name: "POST is not retried", method: http.MethodPost, failures: 1, expectedAttempts: 1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) <= tt.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Write([]byte(`{"status": "ok"}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-key", false)
+			client.RetryMaxWait = 10 * time.Millisecond
+
+			_, err := client.SendRequest(context.Background(), tt.method, "/test", nil)
+
+			if tt.expectError && err == nil {
+				t.Errorf("SendRequest() expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("SendRequest() unexpected error: %v", err)
+			}
+			if got := attempts.Load(); got != tt.expectedAttempts {
+				t.Errorf("server saw %d attempts, want %d", got, tt.expectedAttempts)
+			}
+		})
+	}
+}
+
+func TestClientRetriesPostBeforeSend(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = 500 * time.Millisecond }()
+
+	// A closed server refuses connections, so the request is never sent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	var attempts atomic.Int32
+	client := NewClient(server.URL, "test-key", false)
+	client.RetryMaxWait = 10 * time.Millisecond
+	client.httpClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	if _, err := client.SendRequest(context.Background(), http.MethodPost, "/test", map[string]string{"a": "b"}); err == nil {
+		t.Fatalf("SendRequest() expected error but got none")
+	}
+	if got := attempts.Load(); got != int32(DefaultMaxRetries+1) {
+		t.Errorf("transport saw %d attempts, want %d", got, DefaultMaxRetries+1)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
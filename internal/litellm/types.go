@@ -1,20 +1,24 @@
 package litellm
 
+import "time"
+
 // ProviderConfig holds the configuration for the LiteLLM provider.
 type ProviderConfig struct {
 	APIBase            string
 	APIKey             string
 	InsecureSkipVerify bool
+	MaxRetries         int
+	RetryMaxWait       time.Duration
 }
 
 // ErrorResponse represents an error response from the API.
+// LiteLLM returns either {"error": {"message": ...}} or a FastAPI {"detail": ...} body,
+// where detail is a string or an object with an "error" field.
 type ErrorResponse struct {
 	Error struct {
 		Message interface{} `json:"message"`
 	} `json:"error"`
-	Detail struct {
-		Error string `json:"error"`
-	} `json:"detail"`
+	Detail interface{} `json:"detail"`
 }
 
 // LiteLLMParams represents the parameters for LiteLLM.
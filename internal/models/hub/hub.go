@@ -0,0 +1,30 @@
+package hub
+
+// MakePublicRequest represents the request payload for /model_group/make_public
+type MakePublicRequest struct {
+	ModelGroups []string `json:"model_groups"`
+}
+
+// UsefulLinksRequest represents the request payload for /model_hub/update_useful_links
+type UsefulLinksRequest struct {
+	UsefulLinks map[string]string `json:"useful_links"`
+}
+
+// PublicModelGroup represents a model group entry returned by /public/model_hub
+type PublicModelGroup struct {
+	ModelGroup string   `json:"model_group"`
+	Providers  []string `json:"providers,omitempty"`
+}
+
+// ModelHubInfo represents the response of /public/model_hub/info
+type ModelHubInfo struct {
+	DocsTitle      string            `json:"docs_title,omitempty"`
+	LiteLLMVersion string            `json:"litellm_version,omitempty"`
+	UsefulLinks    map[string]string `json:"useful_links"`
+}
+
+// ModelGroupVisibility is the public model hub state managed by the resource
+type ModelGroupVisibility struct {
+	ModelGroups []string
+	UsefulLinks map[string]string
+}
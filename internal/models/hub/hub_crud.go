@@ -0,0 +1,55 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// makeModelGroupsPublic replaces the set of model groups shown on the public model hub
+func makeModelGroupsPublic(ctx context.Context, c *litellm.Client, modelGroups []string) error {
+	_, err := litellm.SendRequestTyped[MakePublicRequest, interface{}](
+		ctx, c, http.MethodPost, "/model_group/make_public", &MakePublicRequest{ModelGroups: modelGroups},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to make model groups public: %w", err)
+	}
+
+	return nil
+}
+
+// updateUsefulLinks replaces the useful links shown on the public model hub
+func updateUsefulLinks(ctx context.Context, c *litellm.Client, usefulLinks map[string]string) error {
+	_, err := litellm.SendRequestTyped[UsefulLinksRequest, interface{}](
+		ctx, c, http.MethodPost, "/model_hub/update_useful_links", &UsefulLinksRequest{UsefulLinks: usefulLinks},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update model hub useful links: %w", err)
+	}
+
+	return nil
+}
+
+// getModelGroupVisibility reads the public model groups and useful links of the model hub
+func getModelGroupVisibility(ctx context.Context, c *litellm.Client) (*ModelGroupVisibility, error) {
+	groups, err := litellm.SendRequestTyped[interface{}, []PublicModelGroup](
+		ctx, c, http.MethodGet, "/public/model_hub", nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public model hub: %w", err)
+	}
+
+	info, err := litellm.SendRequestTyped[interface{}, ModelHubInfo](
+		ctx, c, http.MethodGet, "/public/model_hub/info", nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public model hub info: %w", err)
+	}
+
+	return &ModelGroupVisibility{
+		ModelGroups: publicModelGroupNames(*groups),
+		UsefulLinks: info.UsefulLinks,
+	}, nil
+}
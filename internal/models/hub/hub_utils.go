@@ -0,0 +1,59 @@
+package hub
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// buildModelGroups returns the configured model groups in a stable order
+func buildModelGroups(d *schema.ResourceData) []string {
+	modelGroups := []string{}
+	for _, v := range d.Get("model_groups").(*schema.Set).List() {
+		modelGroups = append(modelGroups, v.(string))
+	}
+	sort.Strings(modelGroups)
+
+	return modelGroups
+}
+
+// buildUsefulLinks returns the configured useful links
+func buildUsefulLinks(d *schema.ResourceData) map[string]string {
+	usefulLinks := map[string]string{}
+	for k, v := range d.Get("useful_links").(map[string]interface{}) {
+		usefulLinks[k] = v.(string)
+	}
+
+	return usefulLinks
+}
+
+// publicModelGroupNames returns the distinct model group names of the public model hub, sorted
+func publicModelGroupNames(groups []PublicModelGroup) []string {
+	seen := map[string]bool{}
+	names := []string{}
+	for _, group := range groups {
+		if group.ModelGroup == "" || seen[group.ModelGroup] {
+			continue
+		}
+		seen[group.ModelGroup] = true
+		names = append(names, group.ModelGroup)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// setModelGroupVisibilityResourceData sets Terraform resource data from the public model hub state.
+// Both fields are authoritative, so anything published outside Terraform shows up as drift.
+func setModelGroupVisibilityResourceData(d *schema.ResourceData, visibility *ModelGroupVisibility) error {
+	if err := d.Set("model_groups", visibility.ModelGroups); err != nil {
+		return err
+	}
+
+	usefulLinks := visibility.UsefulLinks
+	if usefulLinks == nil {
+		usefulLinks = map[string]string{}
+	}
+
+	return d.Set("useful_links", usefulLinks)
+}
@@ -0,0 +1,83 @@
+package hub
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBuildModelGroupVisibility(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceModelGroupVisibilitySchema(), map[string]interface{}{
+		"model_groups": []interface{}{"gpt-4o", "claude-sonnet", "embeddings"},
+		"useful_links": map[string]interface{}{
+			"Docs": "https://docs.example.com/models",
+		},
+	})
+
+	expectedGroups := []string{"claude-sonnet", "embeddings", "gpt-4o"}
+	if groups := buildModelGroups(d); !reflect.DeepEqual(groups, expectedGroups) {
+		t.Errorf("buildModelGroups() = %v, want %v", groups, expectedGroups)
+	}
+
+	expectedLinks := map[string]string{"Docs": "https://docs.example.com/models"}
+	if links := buildUsefulLinks(d); !reflect.DeepEqual(links, expectedLinks) {
+		t.Errorf("buildUsefulLinks() = %v, want %v", links, expectedLinks)
+	}
+}
+
+func TestPublicModelGroupNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		groups   []PublicModelGroup
+		expected []string
+	}{
+		{
+			name:     "empty hub",
+			groups:   nil,
+			expected: []string{},
+		},
+		{
+			name: "sorted and deduplicated",
+			groups: []PublicModelGroup{
+				{ModelGroup: "gpt-4o", Providers: []string{"openai"}},
+				{ModelGroup: "claude-sonnet", Providers: []string{"anthropic"}},
+				{ModelGroup: "gpt-4o", Providers: []string{"azure"}},
+				{ModelGroup: ""},
+			},
+			expected: []string{"claude-sonnet", "gpt-4o"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := publicModelGroupNames(tt.groups)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("publicModelGroupNames() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetModelGroupVisibilityResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceModelGroupVisibilitySchema(), map[string]interface{}{
+		"model_groups": []interface{}{"gpt-4o"},
+		"useful_links": map[string]interface{}{"Docs": "https://docs.example.com"},
+	})
+
+	visibility := &ModelGroupVisibility{
+		ModelGroups: []string{"claude-sonnet", "gpt-4o"},
+	}
+
+	if err := setModelGroupVisibilityResourceData(d, visibility); err != nil {
+		t.Fatalf("setModelGroupVisibilityResourceData() error = %v", err)
+	}
+
+	if groups := buildModelGroups(d); !reflect.DeepEqual(groups, visibility.ModelGroups) {
+		t.Errorf("model_groups = %v, want %v", groups, visibility.ModelGroups)
+	}
+	if links := d.Get("useful_links").(map[string]interface{}); len(links) != 0 {
+		t.Errorf("useful_links = %v, want empty", links)
+	}
+}
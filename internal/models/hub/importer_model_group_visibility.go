@@ -0,0 +1,13 @@
+package hub
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ModelGroupVisibilityImporter provides import functionality for the LiteLLM model group visibility resource.
+// The import ID is expected to be model_group_visibility.
+func ModelGroupVisibilityImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
@@ -0,0 +1,82 @@
+package hub
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+const modelGroupVisibilityID = "model_group_visibility"
+
+// ResourceModelGroupVisibility defines the schema for the LiteLLM public model hub singleton resource.
+func ResourceModelGroupVisibility() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceModelGroupVisibilityWrite,
+		ReadContext:   resourceModelGroupVisibilityRead,
+		UpdateContext: resourceModelGroupVisibilityWrite,
+		DeleteContext: resourceModelGroupVisibilityDelete,
+		Importer:      ModelGroupVisibilityImporter(),
+		Schema:        resourceModelGroupVisibilitySchema(),
+	}
+}
+
+// resourceModelGroupVisibilityWrite publishes the configured model groups and useful links.
+func resourceModelGroupVisibilityWrite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Writing LiteLLM model group visibility")
+
+	client := m.(*litellm.Client)
+
+	if d.IsNewResource() || d.HasChange("model_groups") {
+		if err := makeModelGroupsPublic(ctx, client, buildModelGroups(d)); err != nil {
+			return diag.Errorf("error writing model group visibility: %v", err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("useful_links") {
+		if err := updateUsefulLinks(ctx, client, buildUsefulLinks(d)); err != nil {
+			return diag.Errorf("error writing model hub useful links: %v", err)
+		}
+	}
+
+	d.SetId(modelGroupVisibilityID)
+
+	return resourceModelGroupVisibilityRead(ctx, d, m)
+}
+
+// resourceModelGroupVisibilityRead reads the public model groups and useful links from LiteLLM.
+func resourceModelGroupVisibilityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM model group visibility")
+
+	client := m.(*litellm.Client)
+
+	visibility, err := getModelGroupVisibility(ctx, client)
+	if err != nil {
+		return diag.Errorf("error reading model group visibility: %v", err)
+	}
+
+	if err := setModelGroupVisibilityResourceData(d, visibility); err != nil {
+		return diag.Errorf("error setting model group visibility data: %v", err)
+	}
+
+	return nil
+}
+
+// resourceModelGroupVisibilityDelete hides all model groups and clears the useful links.
+func resourceModelGroupVisibilityDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Clearing LiteLLM model group visibility")
+
+	client := m.(*litellm.Client)
+
+	if err := makeModelGroupsPublic(ctx, client, []string{}); err != nil {
+		return diag.Errorf("error clearing model group visibility: %v", err)
+	}
+
+	if err := updateUsefulLinks(ctx, client, map[string]string{}); err != nil {
+		return diag.Errorf("error clearing model hub useful links: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package hub
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceModelGroupVisibilitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"model_groups": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Model group names shown on the public model hub. Groups not in this set are hidden.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"useful_links": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Map of link titles to URLs shown on the public model hub",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
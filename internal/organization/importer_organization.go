@@ -0,0 +1,12 @@
+package organization
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// OrganizationImporter provides import functionality for LiteLLM organization resources
+func OrganizationImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
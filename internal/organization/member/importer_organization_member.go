@@ -0,0 +1,40 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// OrganizationMemberImporter provides import functionality for LiteLLM organization member resources
+func OrganizationMemberImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: organizationMemberImportState,
+	}
+}
+
+func organizationMemberImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Expected format: "organization_id:user_id"
+	importID := d.Id()
+
+	parts := strings.Split(importID, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid import ID format. Expected 'organization_id:user_id', got: %s", importID)
+	}
+
+	organizationID := parts[0]
+	userID := parts[1]
+
+	// Validate that both parts are non-empty
+	if organizationID == "" || userID == "" {
+		return nil, fmt.Errorf("invalid import ID format. Both organization_id and user_id must be non-empty. Got: %s", importID)
+	}
+
+	d.SetId(importID)
+	d.Set("organization_id", organizationID)
+	d.Set("user_id", userID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,64 @@
+package member
+
+import (
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// OrganizationMemberAddRequest represents the request for adding members to an organization
+type OrganizationMemberAddRequest struct {
+	OrganizationID          string                  `json:"organization_id"`
+	Member                  []OrganizationMemberAdd `json:"member"`
+	MaxBudgetInOrganization *float64                `json:"max_budget_in_organization,omitempty"`
+}
+
+// OrganizationMemberAdd represents a single member in the add request
+type OrganizationMemberAdd struct {
+	UserID    string  `json:"user_id,omitempty"`
+	UserEmail *string `json:"user_email,omitempty"`
+	Role      string  `json:"role"`
+}
+
+// OrganizationMemberAddResponse represents the response from adding members to an organization
+type OrganizationMemberAddResponse struct {
+	OrganizationID                 string                   `json:"organization_id"`
+	UpdatedUsers                   []interface{}            `json:"updated_users"`
+	UpdatedOrganizationMemberships []OrganizationMembership `json:"updated_organization_memberships"`
+}
+
+// OrganizationMemberUpdateRequest represents the request for updating an organization member
+type OrganizationMemberUpdateRequest struct {
+	OrganizationID          string                  `json:"organization_id"`                     // Required for identification
+	UserID                  string                  `json:"user_id"`                             // Required for identification
+	UserEmail               utils.Nullable[string]  `json:"user_email,omitzero"`                 // Optional - only set if changed, null clears it
+	Role                    *string                 `json:"role,omitempty"`                      // Optional - only set if changed
+	MaxBudgetInOrganization utils.Nullable[float64] `json:"max_budget_in_organization,omitzero"` // Optional - only set if changed, null clears it
+}
+
+// OrganizationMemberDeleteRequest represents the request for removing a member from an organization
+type OrganizationMemberDeleteRequest struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id,omitempty"`
+	UserEmail      string `json:"user_email,omitempty"`
+}
+
+// OrganizationMembership represents an organization membership with budget information
+type OrganizationMembership struct {
+	UserID             string                     `json:"user_id"`
+	OrganizationID     string                     `json:"organization_id"`
+	UserRole           *string                    `json:"user_role"`
+	Spend              float64                    `json:"spend"`
+	BudgetID           *string                    `json:"budget_id"`
+	LitellmBudgetTable *budget.LitellmBudgetTable `json:"litellm_budget_table"`
+}
+
+// OrganizationMemberResponse represents the state of an organization member
+type OrganizationMemberResponse struct {
+	OrganizationID          string
+	UserID                  string
+	UserEmail               string
+	Role                    string
+	MaxBudgetInOrganization float64
+	BudgetID                string
+	Spend                   float64
+}
@@ -0,0 +1,65 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+	"github.com/scalepad/terraform-provider-litellm/internal/organization"
+)
+
+// addOrganizationMember adds a member to an organization.
+// Requests are serialized to avoid concurrent membership writes on the same organization.
+func addOrganizationMember(ctx context.Context, c *litellm.Client, request *OrganizationMemberAddRequest) (*OrganizationMemberAddResponse, error) {
+	response, err := litellm.SendRequestTypedRateLimited[OrganizationMemberAddRequest, OrganizationMemberAddResponse](
+		ctx, c, http.MethodPost, "/organization/member_add", request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add organization member: %w", err)
+	}
+
+	return response, nil
+}
+
+// getOrganizationMember retrieves an organization member through /organization/info.
+// It returns nil when either the organization or the membership no longer exists.
+func getOrganizationMember(ctx context.Context, c *litellm.Client, organizationID, userID string) (*OrganizationMemberResponse, error) {
+	org, err := organization.GetOrganization(ctx, c, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, nil
+	}
+
+	return findOrganizationMember(org, userID), nil
+}
+
+// updateOrganizationMember updates an existing organization member
+func updateOrganizationMember(ctx context.Context, c *litellm.Client, request *OrganizationMemberUpdateRequest) error {
+	_, err := litellm.SendRequestTypedRateLimited[OrganizationMemberUpdateRequest, OrganizationMembership](
+		ctx, c, http.MethodPatch, "/organization/member_update", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update organization member: %w", err)
+	}
+
+	return nil
+}
+
+// deleteOrganizationMember removes a member from an organization
+func deleteOrganizationMember(ctx context.Context, c *litellm.Client, request *OrganizationMemberDeleteRequest) error {
+	_, err := litellm.SendRequestTypedRateLimited[OrganizationMemberDeleteRequest, interface{}](
+		ctx, c, http.MethodDelete, "/organization/member_delete", request,
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete organization member: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceOrganizationMember defines the schema for the LiteLLM organization member resource.
+func ResourceOrganizationMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationMemberCreate,
+		ReadContext:   resourceOrganizationMemberRead,
+		UpdateContext: resourceOrganizationMemberUpdate,
+		DeleteContext: resourceOrganizationMemberDelete,
+		Importer:      OrganizationMemberImporter(),
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the organization the user belongs to",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user to add to the organization",
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Email address of the user",
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"org_admin",
+					"internal_user",
+					"internal_user_viewer",
+				}, false),
+				Description: "Role of the user in the organization. Valid values: org_admin, internal_user, internal_user_viewer",
+			},
+			"max_budget_in_organization": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum budget for the user within the organization",
+			},
+			"budget_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the budget attached to the membership",
+			},
+			"spend": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Current spend of the user within the organization",
+			},
+		},
+	}
+}
+
+// resourceOrganizationMemberCreate adds a member to an organization in LiteLLM.
+func resourceOrganizationMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Creating LiteLLM organization member")
+
+	client := m.(*litellm.Client)
+
+	request := buildOrganizationMemberAddRequest(d)
+
+	if _, err := addOrganizationMember(ctx, client, request); err != nil {
+		return diag.Errorf("error creating organization member: %v", err)
+	}
+
+	// Set a composite ID since there's no specific member ID returned
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("organization_id").(string), d.Get("user_id").(string)))
+
+	tflog.Info(ctx, "Organization member created", map[string]interface{}{
+		"id": d.Id(),
+	})
+
+	return resourceOrganizationMemberRead(ctx, d, m)
+}
+
+// resourceOrganizationMemberRead reads the current state of an organization member from LiteLLM.
+func resourceOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM organization member", map[string]interface{}{
+		"id": d.Id(),
+	})
+
+	client := m.(*litellm.Client)
+
+	// Parse the composite ID to get organization_id and user_id
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 {
+		return diag.Errorf("invalid organization member ID format: %s", d.Id())
+	}
+
+	organizationID := parts[0]
+	userID := parts[1]
+
+	member, err := getOrganizationMember(ctx, client, organizationID, userID)
+	if err != nil {
+		return diag.Errorf("error getting organization info: %v", err)
+	}
+
+	if member == nil {
+		tflog.Warn(ctx, "Organization member not found, removing from state", map[string]interface{}{
+			"organization_id": organizationID,
+			"user_id":         userID,
+		})
+		d.SetId("")
+		return nil
+	}
+
+	if err := setOrganizationMemberResourceData(d, member); err != nil {
+		return diag.Errorf("error setting organization member data: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully read organization member", map[string]interface{}{
+		"id": d.Id(),
+	})
+	return nil
+}
+
+// resourceOrganizationMemberUpdate updates an existing organization member in LiteLLM.
+func resourceOrganizationMemberUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM organization member", map[string]interface{}{
+		"id": d.Id(),
+	})
+
+	client := m.(*litellm.Client)
+
+	request := buildOrganizationMemberUpdateRequest(d)
+
+	if err := updateOrganizationMember(ctx, client, request); err != nil {
+		return diag.Errorf("error updating organization member: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully updated organization member", map[string]interface{}{
+		"id": d.Id(),
+	})
+	return resourceOrganizationMemberRead(ctx, d, m)
+}
+
+// resourceOrganizationMemberDelete removes a member from an organization in LiteLLM.
+func resourceOrganizationMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM organization member", map[string]interface{}{
+		"id": d.Id(),
+	})
+
+	client := m.(*litellm.Client)
+
+	request := &OrganizationMemberDeleteRequest{
+		OrganizationID: d.Get("organization_id").(string),
+		UserID:         d.Get("user_id").(string),
+	}
+
+	if err := deleteOrganizationMember(ctx, client, request); err != nil {
+		return diag.Errorf("error deleting organization member: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully deleted organization member", map[string]interface{}{
+		"id": d.Id(),
+	})
+	return nil
+}
@@ -0,0 +1,77 @@
+package organization
+
+import (
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// OrganizationCreateRequest represents the request payload for creating a new organization
+type OrganizationCreateRequest struct {
+	// Core configuration
+	OrganizationID    *string `json:"organization_id,omitempty"` // The organization id. If none passed, we'll generate it.
+	OrganizationAlias string  `json:"organization_alias"`        // User defined organization alias
+	BudgetID          *string `json:"budget_id,omitempty"`       // An existing budget to attach to the organization
+
+	// Budget and limits, used to create the organization's budget
+	budget.BudgetLimits
+
+	// Access control
+	Models           []string                `json:"models,omitempty"`            // A list of models associated with the organization
+	ObjectPermission *utils.ObjectPermission `json:"object_permission,omitempty"` // Organization-specific object permission
+
+	// Additional configuration
+	Metadata map[string]interface{} `json:"metadata,omitempty"` // Metadata for the organization
+}
+
+// OrganizationUpdateRequest represents the request payload for updating an organization
+type OrganizationUpdateRequest struct {
+	// Required field
+	OrganizationID string `json:"organization_id"` // The organization id. Required param.
+
+	// Core configuration
+	OrganizationAlias *string `json:"organization_alias,omitempty"` // User defined organization alias
+	BudgetID          *string `json:"budget_id,omitempty"`          // The budget attached to the organization
+
+	// Access control
+	Models           *[]string               `json:"models,omitempty"`            // A list of models associated with the organization, an empty list clears them
+	ObjectPermission *utils.ObjectPermission `json:"object_permission,omitempty"` // Organization-specific object permission
+
+	// Additional configuration
+	Metadata *map[string]interface{} `json:"metadata,omitempty"` // Metadata for the organization, an empty map clears it
+}
+
+// OrganizationResponse represents the response from the /organization/new, /organization/info
+// and /organization/update endpoints
+type OrganizationResponse struct {
+	OrganizationID     string                     `json:"organization_id"`
+	OrganizationAlias  string                     `json:"organization_alias"`
+	BudgetID           string                     `json:"budget_id"`
+	Spend              float64                    `json:"spend"`
+	Metadata           map[string]interface{}     `json:"metadata"`
+	Models             []string                   `json:"models"`
+	CreatedBy          string                     `json:"created_by"`
+	UpdatedBy          string                     `json:"updated_by"`
+	CreatedAt          string                     `json:"created_at"`
+	UpdatedAt          string                     `json:"updated_at"`
+	LitellmBudgetTable *budget.LitellmBudgetTable `json:"litellm_budget_table"`
+	ObjectPermission   *utils.ObjectPermission    `json:"object_permission"`
+	ObjectPermissionID *string                    `json:"object_permission_id"`
+	Members            []OrganizationMember       `json:"members"`
+	Teams              []interface{}              `json:"teams"`
+}
+
+// OrganizationMember represents a membership entry returned with an organization
+type OrganizationMember struct {
+	UserID             string                     `json:"user_id"`
+	OrganizationID     string                     `json:"organization_id"`
+	UserRole           *string                    `json:"user_role"`
+	Spend              float64                    `json:"spend"`
+	BudgetID           *string                    `json:"budget_id"`
+	LitellmBudgetTable *budget.LitellmBudgetTable `json:"litellm_budget_table"`
+	User               interface{}                `json:"user"`
+}
+
+// OrganizationDeleteRequest represents the request for deleting organizations
+type OrganizationDeleteRequest struct {
+	OrganizationIDs []string `json:"organization_ids"`
+}
@@ -0,0 +1,84 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// createOrganization creates a new organization using the typed request/response pattern
+func createOrganization(ctx context.Context, c *litellm.Client, request *OrganizationCreateRequest) (*OrganizationResponse, error) {
+	response, err := litellm.SendRequestTyped[OrganizationCreateRequest, OrganizationResponse](
+		ctx, c, http.MethodPost, "/organization/new", request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetOrganization retrieves organization information by organization ID
+func GetOrganization(ctx context.Context, c *litellm.Client, organizationID string) (*OrganizationResponse, error) {
+	response, err := litellm.SendRequestTyped[interface{}, OrganizationResponse](
+		ctx, c, http.MethodGet, fmt.Sprintf("/organization/info?organization_id=%s", url.QueryEscape(organizationID)), nil,
+	)
+	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	return response, nil
+}
+
+// updateOrganization updates an existing organization using the typed request pattern
+func updateOrganization(ctx context.Context, c *litellm.Client, request *OrganizationUpdateRequest) (*OrganizationResponse, error) {
+	response, err := litellm.SendRequestTyped[OrganizationUpdateRequest, OrganizationResponse](
+		ctx, c, http.MethodPatch, "/organization/update", request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization: %w", err)
+	}
+
+	return response, nil
+}
+
+// updateOrganizationBudget updates the budget attached to an organization.
+// /organization/update does not accept budget fields, so limits are changed on the budget itself.
+func updateOrganizationBudget(ctx context.Context, c *litellm.Client, request *budget.BudgetRequest) error {
+	_, err := litellm.SendRequestTyped[budget.BudgetRequest, interface{}](
+		ctx, c, http.MethodPost, "/budget/update", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update organization budget: %w", err)
+	}
+
+	return nil
+}
+
+// deleteOrganization deletes an organization by organization ID
+func deleteOrganization(ctx context.Context, c *litellm.Client, organizationID string) error {
+	deleteRequest := &OrganizationDeleteRequest{
+		OrganizationIDs: []string{organizationID},
+	}
+
+	_, err := litellm.SendRequestTyped[OrganizationDeleteRequest, []OrganizationResponse](
+		ctx, c, http.MethodDelete, "/organization/delete", deleteRequest,
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete organization: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package organization
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// buildOrganizationCreateRequest builds an OrganizationCreateRequest from Terraform resource data
+func buildOrganizationCreateRequest(d *schema.ResourceData) *OrganizationCreateRequest {
+	request := &OrganizationCreateRequest{
+		OrganizationAlias: d.Get("organization_alias").(string),
+	}
+
+	// String fields
+	if v, ok := d.GetOk("organization_id"); ok {
+		request.OrganizationID = utils.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("budget_id"); ok {
+		request.BudgetID = utils.StringPtr(v.(string))
+	}
+
+	// String list fields
+	if v, ok := d.GetOk("models"); ok {
+		request.Models = expandStringList(v.([]interface{}))
+	}
+
+	// Map fields
+	if v, ok := d.GetOk("metadata"); ok {
+		request.Metadata = v.(map[string]interface{})
+	}
+
+	// Nested blocks
+	if v, ok := d.GetOk("object_permission"); ok {
+		request.ObjectPermission = utils.ExpandObjectPermission(v.([]interface{}))
+	}
+
+	// Budget limits are used to create the organization's budget
+	request.BudgetLimits = budget.ExpandBudgetLimits(d)
+
+	return request
+}
+
+// buildOrganizationUpdateRequest builds an OrganizationUpdateRequest from Terraform resource data
+// Only includes fields that have changed
+func buildOrganizationUpdateRequest(d *schema.ResourceData, organizationID string) *OrganizationUpdateRequest {
+	request := &OrganizationUpdateRequest{
+		OrganizationID: organizationID,
+	}
+
+	// String fields - only set if changed
+	if d.HasChange("organization_alias") {
+		if v, ok := d.GetOk("organization_alias"); ok {
+			request.OrganizationAlias = utils.StringPtr(v.(string))
+		}
+	}
+	if d.HasChange("budget_id") {
+		if v, ok := d.GetOk("budget_id"); ok {
+			request.BudgetID = utils.StringPtr(v.(string))
+		}
+	}
+
+	// Lists and maps - only set if changed, empty values clear them
+	if d.HasChange("models") {
+		models := expandStringList(d.Get("models").([]interface{}))
+		request.Models = &models
+	}
+	if d.HasChange("metadata") {
+		metadata := d.Get("metadata").(map[string]interface{})
+		request.Metadata = &metadata
+	}
+
+	// Nested blocks - only set if changed
+	if d.HasChange("object_permission") {
+		request.ObjectPermission = utils.ExpandObjectPermission(d.Get("object_permission").([]interface{}))
+		if request.ObjectPermission == nil {
+			request.ObjectPermission = utils.NewObjectPermission()
+		}
+	}
+
+	return request
+}
+
+// buildOrganizationBudgetUpdateRequest builds the budget update payload for the organization's own budget.
+// It returns nil when none of the budget fields have changed or the organization uses a shared budget.
+func buildOrganizationBudgetUpdateRequest(d *schema.ResourceData) *budget.BudgetRequest {
+	if !d.HasChanges(budget.LimitFields...) || budget.UsesSharedBudget(d) {
+		return nil
+	}
+
+	budgetID := d.Get("budget_id").(string)
+	if budgetID == "" {
+		return nil
+	}
+
+	return &budget.BudgetRequest{
+		BudgetID:     budgetID,
+		BudgetLimits: budget.ExpandBudgetLimits(d),
+	}
+}
+
+// setOrganizationResourceData sets Terraform resource data from an OrganizationResponse
+func setOrganizationResourceData(d *schema.ResourceData, org *OrganizationResponse) error {
+	// Decide before budget_id is read back, an imported organization has none in state yet
+	sharedBudget := budget.UsesSharedBudget(d)
+
+	fields := map[string]interface{}{
+		"organization_id":    org.OrganizationID,
+		"organization_alias": org.OrganizationAlias,
+		"budget_id":          org.BudgetID,
+		"created_by":         org.CreatedBy,
+		"updated_by":         org.UpdatedBy,
+		"created_at":         org.CreatedAt,
+		"updated_at":         org.UpdatedAt,
+	}
+
+	for field, value := range fields {
+		// Use SetIfNotZero to preserve existing values when API doesn't return them
+		utils.SetIfNotZero(d, field, value)
+	}
+
+	// Always set computed spend, including zero
+	if err := d.Set("spend", org.Spend); err != nil {
+		return err
+	}
+
+	if org.Models != nil {
+		if err := d.Set("models", org.Models); err != nil {
+			return err
+		}
+	}
+
+	if org.Metadata != nil {
+		if err := d.Set("metadata", org.Metadata); err != nil {
+			return err
+		}
+	}
+
+	// Budget limits are returned through the attached budget table.
+	// The limits of a shared budget belong to its own resource and are not copied into the organization.
+	if !sharedBudget {
+		if err := budget.SetBudgetLimits(d, org.LitellmBudgetTable); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("object_permission", utils.FlattenObjectPermission(org.ObjectPermission)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// expandStringList converts []interface{} to []string
+func expandStringList(list []interface{}) []string {
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
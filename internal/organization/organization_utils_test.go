@@ -0,0 +1,351 @@
+package organization
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils/testutil"
+)
+
+func TestBuildOrganizationCreateRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]interface{}
+		expected *OrganizationCreateRequest
+	}{
+		{
+			name: "complete organization data",
+			input: map[string]interface{}{
+				"organization_id":       "org-123",
+				"organization_alias":    "acme",
+				"budget_duration":       "30d",
+				"max_budget":            500.0,
+				"soft_budget":           400.0,
+				"max_parallel_requests": 10,
+				"tpm_limit":             100000,
+				"rpm_limit":             1000,
+				"models":                []interface{}{"gpt-4o", "claude-3-5-sonnet"},
+				"metadata":              map[string]interface{}{"env": "prod"},
+				"model_max_budget": []interface{}{
+					map[string]interface{}{
+						"model":           "gpt-4o",
+						"max_budget":      100.0,
+						"budget_duration": "1d",
+					},
+				},
+				"object_permission": []interface{}{
+					map[string]interface{}{
+						"vector_stores":     []interface{}{"vs-1"},
+						"mcp_servers":       []interface{}{"mcp-1"},
+						"mcp_access_groups": []interface{}{"group-a"},
+					},
+				},
+			},
+			expected: &OrganizationCreateRequest{
+				OrganizationID:    stringPtr("org-123"),
+				OrganizationAlias: "acme",
+				BudgetLimits: budget.BudgetLimits{
+					BudgetDuration:      utils.NullableValue("30d"),
+					MaxBudget:           utils.NullableValue(500.0),
+					SoftBudget:          utils.NullableValue(400.0),
+					MaxParallelRequests: utils.NullableValue(10),
+					TPMLimit:            utils.NullableValue(100000),
+					RPMLimit:            utils.NullableValue(1000),
+					ModelMaxBudget: utils.NullableValue(map[string]budget.BudgetConfig{
+						"gpt-4o": {
+							MaxBudget:      float64Ptr(100.0),
+							BudgetDuration: stringPtr("1d"),
+						},
+					}),
+				},
+				Models:   []string{"gpt-4o", "claude-3-5-sonnet"},
+				Metadata: map[string]interface{}{"env": "prod"},
+				ObjectPermission: &utils.ObjectPermission{
+					VectorStores:    []string{"vs-1"},
+					MCPServers:      []string{"mcp-1"},
+					MCPAccessGroups: []string{"group-a"},
+				},
+			},
+		},
+		{
+			name: "shared budget",
+			input: map[string]interface{}{
+				"organization_alias": "shared",
+				"budget_id":          "budget-1",
+			},
+			expected: &OrganizationCreateRequest{
+				OrganizationAlias: "shared",
+				BudgetID:          stringPtr("budget-1"),
+			},
+		},
+		{
+			name: "alias only",
+			input: map[string]interface{}{
+				"organization_alias": "minimal",
+			},
+			expected: &OrganizationCreateRequest{
+				OrganizationAlias: "minimal",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := ResourceOrganization()
+			d := schema.TestResourceDataRaw(t, resource.Schema, tt.input)
+
+			result := buildOrganizationCreateRequest(d)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildOrganizationCreateRequest() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetOrganizationResourceData(t *testing.T) {
+	tests := []struct {
+		name    string
+		orgResp *OrganizationResponse
+	}{
+		{
+			name: "complete organization data",
+			orgResp: &OrganizationResponse{
+				OrganizationID:    "org-123",
+				OrganizationAlias: "acme",
+				BudgetID:          "budget-1",
+				Spend:             12.5,
+				Models:            []string{"gpt-4o"},
+				Metadata:          map[string]interface{}{"env": "prod"},
+				LitellmBudgetTable: &budget.LitellmBudgetTable{
+					BudgetID:       "budget-1",
+					MaxBudget:      float64Ptr(500.0),
+					TPMLimit:       intPtr(1000),
+					BudgetDuration: stringPtr("30d"),
+					ModelMaxBudget: map[string]budget.BudgetConfig{
+						"gpt-4o": {MaxBudget: float64Ptr(100.0)},
+					},
+				},
+				ObjectPermission: &utils.ObjectPermission{
+					ObjectPermissionID: "perm-1",
+					VectorStores:       []string{"vs-1"},
+				},
+			},
+		},
+		{
+			name: "minimal organization data",
+			orgResp: &OrganizationResponse{
+				OrganizationID:    "org-minimal",
+				OrganizationAlias: "minimal",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := ResourceOrganization()
+			d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+
+			if err := setOrganizationResourceData(d, tt.orgResp); err != nil {
+				t.Fatalf("setOrganizationResourceData() unexpected error: %v", err)
+			}
+
+			if d.Get("organization_alias") != tt.orgResp.OrganizationAlias {
+				t.Errorf("Expected organization_alias %s, got %v", tt.orgResp.OrganizationAlias, d.Get("organization_alias"))
+			}
+			if d.Get("spend") != tt.orgResp.Spend {
+				t.Errorf("Expected spend %f, got %v", tt.orgResp.Spend, d.Get("spend"))
+			}
+
+			table := tt.orgResp.LitellmBudgetTable
+			if table != nil {
+				if d.Get("max_budget") != *table.MaxBudget {
+					t.Errorf("Expected max_budget %f, got %v", *table.MaxBudget, d.Get("max_budget"))
+				}
+				if d.Get("model_max_budget").(*schema.Set).Len() != len(table.ModelMaxBudget) {
+					t.Errorf("Expected %d model_max_budget entries, got %d", len(table.ModelMaxBudget), d.Get("model_max_budget").(*schema.Set).Len())
+				}
+			}
+
+			permissions := d.Get("object_permission").([]interface{})
+			if tt.orgResp.ObjectPermission == nil && len(permissions) != 0 {
+				t.Errorf("Expected no object_permission block, got %v", permissions)
+			}
+			if tt.orgResp.ObjectPermission != nil && len(permissions) != 1 {
+				t.Errorf("Expected one object_permission block, got %v", permissions)
+			}
+		})
+	}
+}
+
+func TestBuildOrganizationUpdateRequestLogic(t *testing.T) {
+	// HasChange only reports changes against real prior state, so with fresh
+	// resource data only the organization ID should be populated
+	resource := ResourceOrganization()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+
+	result := buildOrganizationUpdateRequest(d, "org-123")
+
+	if result.OrganizationID != "org-123" {
+		t.Errorf("Expected OrganizationID to be 'org-123', got %s", result.OrganizationID)
+	}
+	if result.OrganizationAlias != nil {
+		t.Errorf("Expected OrganizationAlias to be nil when no data provided, got %v", result.OrganizationAlias)
+	}
+	if budgetRequest := buildOrganizationBudgetUpdateRequest(d); budgetRequest != nil {
+		t.Errorf("Expected no budget update request, got %+v", budgetRequest)
+	}
+}
+
+func TestBuildOrganizationUpdateRequestClearsRemovedFields(t *testing.T) {
+	state := map[string]interface{}{
+		"organization_alias": "acme",
+		"models":             []interface{}{"gpt-4o"},
+		"metadata":           map[string]interface{}{"env": "prod"},
+	}
+	config := map[string]interface{}{
+		"organization_alias": "acme",
+	}
+
+	d := testutil.ResourceDataWithState(t, ResourceOrganization(), state, config)
+	result := buildOrganizationUpdateRequest(d, "org-123")
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	expectedBody := `{"organization_id":"org-123","models":[],"metadata":{}}`
+	if string(body) != expectedBody {
+		t.Errorf("request body = %s, want %s", body, expectedBody)
+	}
+}
+
+func TestSetOrganizationResourceDataSharedBudget(t *testing.T) {
+	resource := ResourceOrganization()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"organization_alias": "acme",
+		"budget_id":          "shared-budget",
+	})
+
+	orgResp := &OrganizationResponse{
+		OrganizationID:    "org-123",
+		OrganizationAlias: "acme",
+		BudgetID:          "shared-budget",
+		LitellmBudgetTable: &budget.LitellmBudgetTable{
+			BudgetID:  "shared-budget",
+			MaxBudget: float64Ptr(50.0),
+			TPMLimit:  intPtr(1000),
+		},
+	}
+
+	if err := setOrganizationResourceData(d, orgResp); err != nil {
+		t.Fatalf("setOrganizationResourceData() unexpected error: %v", err)
+	}
+
+	// The shared budget's limits must not end up in the organization, they are not in its configuration
+	if v, ok := d.GetOk("max_budget"); ok {
+		t.Errorf("Expected max_budget to stay unset, got %v", v)
+	}
+	if v, ok := d.GetOk("tpm_limit"); ok {
+		t.Errorf("Expected tpm_limit to stay unset, got %v", v)
+	}
+}
+
+func TestBuildOrganizationBudgetUpdateRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    map[string]interface{}
+		config   map[string]interface{}
+		expected *budget.BudgetRequest
+	}{
+		{
+			name:   "own budget is updated",
+			state:  map[string]interface{}{"organization_alias": "acme", "budget_id": "budget-1", "max_budget": 100.0, "rpm_limit": 100},
+			config: map[string]interface{}{"organization_alias": "acme", "max_budget": 200.0},
+			expected: &budget.BudgetRequest{
+				BudgetID: "budget-1",
+				BudgetLimits: budget.BudgetLimits{
+					MaxBudget: utils.NullableValue(200.0),
+					RPMLimit:  utils.NullValue[int](),
+				},
+			},
+		},
+		{
+			name:     "unchanged limits",
+			state:    map[string]interface{}{"organization_alias": "acme", "budget_id": "budget-1", "max_budget": 100.0},
+			config:   map[string]interface{}{"organization_alias": "renamed", "max_budget": 100.0},
+			expected: nil,
+		},
+		{
+			name:     "shared budget is never updated",
+			state:    map[string]interface{}{"organization_alias": "acme", "budget_id": "budget-1", "max_budget": 100.0},
+			config:   map[string]interface{}{"organization_alias": "acme", "budget_id": "shared-budget"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testutil.ResourceDataWithState(t, ResourceOrganization(), tt.state, tt.config)
+
+			result := buildOrganizationBudgetUpdateRequest(d)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildOrganizationBudgetUpdateRequest() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOrganizationBudgetIDConflictsWithLimits(t *testing.T) {
+	diags := ResourceOrganization().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"organization_alias": "acme",
+		"budget_id":          "shared-budget",
+		"max_budget":         100.0,
+	}))
+
+	if !diags.HasError() {
+		t.Errorf("Expected budget_id and max_budget to conflict")
+	}
+}
+
+func TestOrganizationReplacedWhenLeavingSharedBudget(t *testing.T) {
+	resource := ResourceOrganization()
+
+	prior := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"organization_alias": "acme",
+		"budget_id":          "shared-budget",
+	})
+	prior.SetId("org-123")
+
+	diff, err := resource.Diff(context.Background(), prior.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"organization_alias": "acme",
+		"max_budget":         100.0,
+	}), nil)
+	if err != nil {
+		t.Fatalf("Diff() unexpected error: %v", err)
+	}
+
+	if !diff.RequiresNew() {
+		t.Errorf("Expected moving from a shared budget to inline limits to replace the organization")
+	}
+}
+
+// Helper functions for creating pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
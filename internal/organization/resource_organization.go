@@ -0,0 +1,130 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+	"github.com/scalepad/terraform-provider-litellm/internal/tools/mcp"
+)
+
+// ResourceOrganization defines the schema for the LiteLLM organization resource.
+func ResourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationCreate,
+		ReadContext:   resourceOrganizationRead,
+		UpdateContext: resourceOrganizationUpdate,
+		DeleteContext: resourceOrganizationDelete,
+		CustomizeDiff: customdiff.All(
+			mcp.ValidateAccessGroupsDiff("object_permission.0.mcp_access_groups"),
+			budget.ReplaceOnSharedBudgetRemoval,
+		),
+		Importer: OrganizationImporter(),
+		Schema:   resourceOrganizationSchema(),
+	}
+}
+
+// resourceOrganizationCreate creates a new organization in LiteLLM.
+func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Creating LiteLLM organization")
+
+	client := m.(*litellm.Client)
+
+	request := buildOrganizationCreateRequest(d)
+
+	// Generate UUIDv7 if organization_id is not provided
+	if request.OrganizationID == nil {
+		orgUUID, err := uuid.NewV7()
+		if err != nil {
+			return diag.Errorf("failed to generate organization ID: %v", err)
+		}
+		orgID := orgUUID.String()
+		request.OrganizationID = &orgID
+	}
+
+	orgResp, err := createOrganization(ctx, client, request)
+	if err != nil {
+		return diag.Errorf("error creating organization: %v", err)
+	}
+
+	orgID := orgResp.OrganizationID
+	if orgID == "" {
+		orgID = *request.OrganizationID
+	}
+
+	d.SetId(orgID)
+	tflog.Info(ctx, "Created organization", map[string]interface{}{"organization_id": orgID})
+
+	return resourceOrganizationRead(ctx, d, m)
+}
+
+// resourceOrganizationRead reads the current state of an organization from LiteLLM.
+func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM organization", map[string]interface{}{"organization_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	orgResp, err := GetOrganization(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error reading organization: %v", err)
+	}
+
+	if orgResp == nil {
+		tflog.Warn(ctx, "Organization not found, removing from state", map[string]interface{}{"organization_id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := setOrganizationResourceData(d, orgResp); err != nil {
+		return diag.Errorf("error setting organization data: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully read organization", map[string]interface{}{"organization_id": d.Id()})
+	return nil
+}
+
+// resourceOrganizationUpdate updates an existing organization in LiteLLM.
+func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM organization", map[string]interface{}{"organization_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	request := buildOrganizationUpdateRequest(d, d.Id())
+
+	if _, err := updateOrganization(ctx, client, request); err != nil {
+		return diag.Errorf("error updating organization: %v", err)
+	}
+
+	if budgetRequest := buildOrganizationBudgetUpdateRequest(d); budgetRequest != nil {
+		tflog.Info(ctx, "Updating organization budget", map[string]interface{}{
+			"organization_id": d.Id(),
+			"budget_id":       budgetRequest.BudgetID,
+		})
+
+		if err := updateOrganizationBudget(ctx, client, budgetRequest); err != nil {
+			return diag.Errorf("error updating organization budget: %v", err)
+		}
+	}
+
+	tflog.Info(ctx, "Successfully updated organization", map[string]interface{}{"organization_id": d.Id()})
+	return resourceOrganizationRead(ctx, d, m)
+}
+
+// resourceOrganizationDelete deletes an organization from LiteLLM.
+func resourceOrganizationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM organization", map[string]interface{}{"organization_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if err := deleteOrganization(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error deleting organization: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully deleted organization", map[string]interface{}{"organization_id": d.Id()})
+	return nil
+}
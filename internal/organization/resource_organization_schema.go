@@ -0,0 +1,173 @@
+package organization
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceOrganizationSchema returns the schema for the organization resource
+func resourceOrganizationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"organization_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Unique identifier for the organization. If not set, a unique id will be generated.",
+		},
+		"organization_alias": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Human-readable name of the organization",
+		},
+		"models": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "List of models the organization has access to",
+		},
+		"metadata": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Additional metadata for the organization",
+		},
+		"budget_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "ID of an existing budget to attach to the organization. Conflicts with the inline limits. If not set, a budget is created from the inline limits.",
+		},
+		"max_budget": {
+			Type:          schema.TypeFloat,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Maximum budget allowed for the organization",
+		},
+		"soft_budget": {
+			Type:          schema.TypeFloat,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Soft budget limit that triggers alerts but doesn't block requests",
+		},
+		"max_parallel_requests": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Maximum number of parallel requests allowed for the organization",
+		},
+		"tpm_limit": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Tokens per minute limit for the organization",
+		},
+		"rpm_limit": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Requests per minute limit for the organization",
+		},
+		"budget_duration": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(\d+[smhd])$`),
+				"Budget duration must be in format: number followed by 's' (seconds), 'm' (minutes), 'h' (hours), or 'd' (days). Examples: '30s', '30m', '30h', '30d'",
+			),
+			Description: "Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ('30s'), minutes ('30m'), hours ('30h'), days ('30d').",
+		},
+		"model_max_budget": {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"budget_id"},
+			Description:   "Model-specific budgets for the organization",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"model": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the model the budget applies to",
+					},
+					"max_budget": {
+						Type:        schema.TypeFloat,
+						Optional:    true,
+						Description: "Maximum budget for the model",
+					},
+					"budget_duration": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Duration after which the model budget is reset",
+					},
+					"tpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Tokens per minute limit for the model",
+					},
+					"rpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Requests per minute limit for the model",
+					},
+				},
+			},
+		},
+		"object_permission": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Object-level permissions for the organization",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vector_stores": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of vector store IDs the organization can access",
+					},
+					"mcp_servers": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of MCP server IDs the organization can access",
+					},
+					"mcp_access_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of MCP access groups the organization can access",
+					},
+				},
+			},
+		},
+		// Computed fields
+		"spend": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Current spend amount for the organization",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "User who created the organization",
+		},
+		"updated_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "User who last updated the organization",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the organization was created",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the organization was last updated",
+		},
+	}
+}
@@ -0,0 +1,12 @@
+package passthrough
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PassThroughEndpointImporter provides import functionality for LiteLLM pass-through endpoint resources
+func PassThroughEndpointImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
@@ -0,0 +1,19 @@
+package passthrough
+
+// PassThroughEndpoint represents a pass-through endpoint as sent to and returned by the API.
+// forward_headers and auth are not part of the documented endpoint, so they may be missing from responses.
+type PassThroughEndpoint struct {
+	ID             string                 `json:"id,omitempty"`
+	Path           string                 `json:"path"`
+	Target         string                 `json:"target"`
+	Headers        map[string]interface{} `json:"headers"`
+	ForwardHeaders *bool                  `json:"forward_headers,omitempty"`
+	IncludeSubpath bool                   `json:"include_subpath"`
+	Auth           *bool                  `json:"auth,omitempty"`
+	CostPerRequest float64                `json:"cost_per_request"`
+}
+
+// PassThroughEndpointListResponse represents the response from GET /config/pass_through_endpoint
+type PassThroughEndpointListResponse struct {
+	Endpoints []PassThroughEndpoint `json:"endpoints"`
+}
@@ -0,0 +1,73 @@
+package passthrough
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// createPassThroughEndpoint creates a new pass-through endpoint
+func createPassThroughEndpoint(ctx context.Context, c *litellm.Client, request *PassThroughEndpoint) error {
+	_, err := litellm.SendRequestTyped[PassThroughEndpoint, interface{}](
+		ctx, c, http.MethodPost, "/config/pass_through_endpoint", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pass-through endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// getPassThroughEndpoint retrieves a pass-through endpoint by endpoint ID.
+// It returns nil without an error when the endpoint does not exist.
+func getPassThroughEndpoint(ctx context.Context, c *litellm.Client, endpointID string) (*PassThroughEndpoint, error) {
+	response, err := litellm.SendRequestTyped[interface{}, PassThroughEndpointListResponse](
+		ctx, c, http.MethodGet, fmt.Sprintf("/config/pass_through_endpoint?endpoint_id=%s", url.QueryEscape(endpointID)), nil,
+	)
+	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pass-through endpoint: %w", err)
+	}
+
+	for _, endpoint := range response.Endpoints {
+		if endpoint.ID == endpointID {
+			return &endpoint, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// updatePassThroughEndpoint updates an existing pass-through endpoint
+func updatePassThroughEndpoint(ctx context.Context, c *litellm.Client, endpointID string, request *PassThroughEndpoint) error {
+	_, err := litellm.SendRequestTyped[PassThroughEndpoint, interface{}](
+		ctx, c, http.MethodPost, fmt.Sprintf("/config/pass_through_endpoint/%s", url.PathEscape(endpointID)), request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update pass-through endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// deletePassThroughEndpoint deletes a pass-through endpoint by endpoint ID
+func deletePassThroughEndpoint(ctx context.Context, c *litellm.Client, endpointID string) error {
+	_, err := litellm.SendRequestTyped[interface{}, interface{}](
+		ctx, c, http.MethodDelete, fmt.Sprintf("/config/pass_through_endpoint?endpoint_id=%s", url.QueryEscape(endpointID)), nil,
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete pass-through endpoint: %w", err)
+	}
+
+	return nil
+}
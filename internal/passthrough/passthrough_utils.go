@@ -0,0 +1,88 @@
+package passthrough
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// buildPassThroughEndpointRequest builds a PassThroughEndpoint from Terraform resource data
+func buildPassThroughEndpointRequest(d *schema.ResourceData, endpointID string) *PassThroughEndpoint {
+	request := &PassThroughEndpoint{
+		ID:     endpointID,
+		Path:   d.Get("path").(string),
+		Target: d.Get("target").(string),
+		// Boolean fields - use d.Get() to include false values
+		ForwardHeaders: utils.BoolPtr(d.Get("forward_headers").(bool)),
+		IncludeSubpath: d.Get("include_subpath").(bool),
+		Auth:           utils.BoolPtr(d.Get("auth").(bool)),
+		CostPerRequest: d.Get("cost_per_request").(float64),
+		Headers:        map[string]interface{}{},
+	}
+
+	if v, ok := d.GetOk("headers"); ok {
+		request.Headers = v.(map[string]interface{})
+	}
+
+	return request
+}
+
+// setPassThroughEndpointResourceData sets Terraform resource data from a PassThroughEndpoint
+func setPassThroughEndpointResourceData(d *schema.ResourceData, endpoint *PassThroughEndpoint) error {
+	fields := map[string]interface{}{
+		"endpoint_id": endpoint.ID,
+		"path":        endpoint.Path,
+		"target":      endpoint.Target,
+	}
+
+	for field, value := range fields {
+		// Use SetIfNotZero to preserve existing values when API doesn't return them
+		utils.SetIfNotZero(d, field, value)
+	}
+
+	// Always set boolean and numeric fields, including false and zero values
+	if err := d.Set("include_subpath", endpoint.IncludeSubpath); err != nil {
+		return err
+	}
+	if err := d.Set("cost_per_request", endpoint.CostPerRequest); err != nil {
+		return err
+	}
+
+	// forward_headers and auth are kept from state when the API doesn't return them
+	if endpoint.ForwardHeaders != nil {
+		if err := d.Set("forward_headers", *endpoint.ForwardHeaders); err != nil {
+			return err
+		}
+	}
+	if endpoint.Auth != nil {
+		if err := d.Set("auth", *endpoint.Auth); err != nil {
+			return err
+		}
+	}
+
+	if endpoint.Headers != nil {
+		headers := flattenHeaders(endpoint.Headers, d.Get("headers").(map[string]interface{}))
+		if err := d.Set("headers", headers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenHeaders converts the headers returned by the API for a TypeMap field.
+// Masked values keep their configured value, since the proxy may mask credentials.
+func flattenHeaders(apiHeaders map[string]interface{}, stateHeaders map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(apiHeaders))
+	for key, apiValue := range apiHeaders {
+		value := fmt.Sprintf("%v", apiValue)
+		if stateValue, ok := stateHeaders[key]; ok && strings.Contains(value, "**") {
+			result[key] = stateValue
+			continue
+		}
+		result[key] = value
+	}
+	return result
+}
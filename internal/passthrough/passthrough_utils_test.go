@@ -0,0 +1,130 @@
+package passthrough
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBuildPassThroughEndpointRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]interface{}
+		expected *PassThroughEndpoint
+	}{
+		{
+			name: "complete endpoint data",
+			input: map[string]interface{}{
+				"path":             "/internal/search",
+				"target":           "https://search.internal.example.com/v1",
+				"headers":          map[string]interface{}{"Authorization": "Bearer secret"},
+				"forward_headers":  true,
+				"include_subpath":  true,
+				"auth":             true,
+				"cost_per_request": 0.01,
+			},
+			expected: &PassThroughEndpoint{
+				ID:             "endpoint-1",
+				Path:           "/internal/search",
+				Target:         "https://search.internal.example.com/v1",
+				Headers:        map[string]interface{}{"Authorization": "Bearer secret"},
+				ForwardHeaders: boolPtr(true),
+				IncludeSubpath: true,
+				Auth:           boolPtr(true),
+				CostPerRequest: 0.01,
+			},
+		},
+		{
+			name: "minimal endpoint data",
+			input: map[string]interface{}{
+				"path":   "/internal/health",
+				"target": "http://health.internal",
+			},
+			expected: &PassThroughEndpoint{
+				ID:             "endpoint-1",
+				Path:           "/internal/health",
+				Target:         "http://health.internal",
+				Headers:        map[string]interface{}{},
+				ForwardHeaders: boolPtr(false),
+				Auth:           boolPtr(false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := ResourcePassThroughEndpoint()
+			d := schema.TestResourceDataRaw(t, resource.Schema, tt.input)
+
+			result := buildPassThroughEndpointRequest(d, "endpoint-1")
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildPassThroughEndpointRequest() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetPassThroughEndpointResourceData(t *testing.T) {
+	tests := []struct {
+		name            string
+		endpoint        *PassThroughEndpoint
+		expectedAuth    bool
+		expectedHeaders map[string]interface{}
+	}{
+		{
+			name: "documented response without auth",
+			endpoint: &PassThroughEndpoint{
+				ID:      "endpoint-1",
+				Path:    "/internal/search",
+				Target:  "https://search.internal.example.com/v2",
+				Headers: map[string]interface{}{"Authorization": "Bearer ****", "X-Team": "search"},
+			},
+			expectedAuth:    true,
+			expectedHeaders: map[string]interface{}{"Authorization": "Bearer secret", "X-Team": "search"},
+		},
+		{
+			name: "response with auth",
+			endpoint: &PassThroughEndpoint{
+				ID:      "endpoint-1",
+				Path:    "/internal/search",
+				Target:  "https://search.internal.example.com/v2",
+				Headers: map[string]interface{}{"Authorization": "Bearer rotated"},
+				Auth:    boolPtr(false),
+			},
+			expectedAuth:    false,
+			expectedHeaders: map[string]interface{}{"Authorization": "Bearer rotated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := ResourcePassThroughEndpoint()
+			d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+				"path":    "/internal/search",
+				"target":  "https://search.internal.example.com",
+				"headers": map[string]interface{}{"Authorization": "Bearer secret"},
+				"auth":    true,
+			})
+
+			if err := setPassThroughEndpointResourceData(d, tt.endpoint); err != nil {
+				t.Fatalf("setPassThroughEndpointResourceData() unexpected error: %v", err)
+			}
+
+			if d.Get("target") != tt.endpoint.Target {
+				t.Errorf("Expected target %s, got %v", tt.endpoint.Target, d.Get("target"))
+			}
+			if d.Get("auth") != tt.expectedAuth {
+				t.Errorf("Expected auth %t, got %v", tt.expectedAuth, d.Get("auth"))
+			}
+			if headers := d.Get("headers").(map[string]interface{}); !reflect.DeepEqual(headers, tt.expectedHeaders) {
+				t.Errorf("Expected headers %v, got %v", tt.expectedHeaders, headers)
+			}
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
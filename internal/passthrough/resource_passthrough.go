@@ -0,0 +1,106 @@
+package passthrough
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourcePassThroughEndpoint defines the schema for the LiteLLM pass-through endpoint resource.
+func ResourcePassThroughEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePassThroughEndpointCreate,
+		ReadContext:   resourcePassThroughEndpointRead,
+		UpdateContext: resourcePassThroughEndpointUpdate,
+		DeleteContext: resourcePassThroughEndpointDelete,
+		Importer:      PassThroughEndpointImporter(),
+		Schema:        resourcePassThroughEndpointSchema(),
+	}
+}
+
+// resourcePassThroughEndpointCreate creates a new pass-through endpoint in LiteLLM.
+func resourcePassThroughEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Creating LiteLLM pass-through endpoint", map[string]interface{}{"path": d.Get("path")})
+
+	client := m.(*litellm.Client)
+
+	// Generate UUIDv7 if endpoint_id is not provided
+	endpointID := d.Get("endpoint_id").(string)
+	if endpointID == "" {
+		endpointUUID, err := uuid.NewV7()
+		if err != nil {
+			return diag.Errorf("failed to generate endpoint ID: %v", err)
+		}
+		endpointID = endpointUUID.String()
+	}
+
+	request := buildPassThroughEndpointRequest(d, endpointID)
+
+	if err := createPassThroughEndpoint(ctx, client, request); err != nil {
+		return diag.Errorf("error creating pass-through endpoint: %v", err)
+	}
+
+	d.SetId(endpointID)
+	tflog.Info(ctx, "Created pass-through endpoint", map[string]interface{}{"endpoint_id": endpointID})
+
+	return resourcePassThroughEndpointRead(ctx, d, m)
+}
+
+// resourcePassThroughEndpointRead reads the current state of a pass-through endpoint from LiteLLM.
+func resourcePassThroughEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM pass-through endpoint", map[string]interface{}{"endpoint_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	endpoint, err := getPassThroughEndpoint(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error reading pass-through endpoint: %v", err)
+	}
+
+	if endpoint == nil {
+		tflog.Warn(ctx, "Pass-through endpoint not found, removing from state", map[string]interface{}{"endpoint_id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := setPassThroughEndpointResourceData(d, endpoint); err != nil {
+		return diag.Errorf("error setting pass-through endpoint data: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully read pass-through endpoint", map[string]interface{}{"endpoint_id": d.Id()})
+	return nil
+}
+
+// resourcePassThroughEndpointUpdate updates an existing pass-through endpoint in LiteLLM.
+func resourcePassThroughEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM pass-through endpoint", map[string]interface{}{"endpoint_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	request := buildPassThroughEndpointRequest(d, d.Id())
+
+	if err := updatePassThroughEndpoint(ctx, client, d.Id(), request); err != nil {
+		return diag.Errorf("error updating pass-through endpoint: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully updated pass-through endpoint", map[string]interface{}{"endpoint_id": d.Id()})
+	return resourcePassThroughEndpointRead(ctx, d, m)
+}
+
+// resourcePassThroughEndpointDelete deletes a pass-through endpoint from LiteLLM.
+func resourcePassThroughEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM pass-through endpoint", map[string]interface{}{"endpoint_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if err := deletePassThroughEndpoint(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error deleting pass-through endpoint: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully deleted pass-through endpoint", map[string]interface{}{"endpoint_id": d.Id()})
+	return nil
+}
@@ -0,0 +1,66 @@
+package passthrough
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourcePassThroughEndpointSchema returns the schema for the pass-through endpoint resource
+func resourcePassThroughEndpointSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"endpoint_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Unique identifier for the pass-through endpoint. If not set, a unique id will be generated.",
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^/`),
+				"Path must start with '/'",
+			),
+			Description: "Route exposed on the LiteLLM proxy, e.g. '/internal/search'",
+		},
+		"target": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "URL requests to the path are forwarded to",
+		},
+		"headers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Headers added to forwarded requests, e.g. credentials for the target service",
+		},
+		"forward_headers": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the incoming request headers are forwarded to the target",
+		},
+		"include_subpath": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether requests to sub-paths of the path are also forwarded, with the sub-path appended to the target",
+		},
+		"auth": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether callers must authenticate with a LiteLLM virtual key",
+		},
+		"cost_per_request": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Cost tracked for each request to the endpoint",
+		},
+	}
+}
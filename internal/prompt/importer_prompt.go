@@ -0,0 +1,12 @@
+package prompt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PromptImporter provides import functionality for LiteLLM prompt resources
+func PromptImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
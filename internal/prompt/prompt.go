@@ -0,0 +1,34 @@
+package prompt
+
+// PromptLiteLLMParams represents the litellm_params of a prompt
+type PromptLiteLLMParams struct {
+	PromptID          string  `json:"prompt_id"`
+	PromptIntegration string  `json:"prompt_integration"`
+	DotpromptContent  *string `json:"dotprompt_content,omitempty"`
+}
+
+// PromptInfo represents the prompt_info of a prompt
+type PromptInfo struct {
+	PromptType string `json:"prompt_type"`
+}
+
+// PromptRequest represents the request body for creating and updating a prompt
+type PromptRequest struct {
+	PromptID      string              `json:"prompt_id"`
+	LiteLLMParams PromptLiteLLMParams `json:"litellm_params"`
+	PromptInfo    PromptInfo          `json:"prompt_info"`
+}
+
+// PromptSpec represents a prompt as stored by LiteLLM
+type PromptSpec struct {
+	PromptID      string              `json:"prompt_id"`
+	LiteLLMParams PromptLiteLLMParams `json:"litellm_params"`
+	PromptInfo    *PromptInfo         `json:"prompt_info"`
+	CreatedAt     string              `json:"created_at"`
+	UpdatedAt     string              `json:"updated_at"`
+}
+
+// PromptInfoResponse represents the response from GET /prompts/{prompt_id}
+type PromptInfoResponse struct {
+	PromptSpec *PromptSpec `json:"prompt_spec"`
+}
@@ -0,0 +1,67 @@
+package prompt
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// createPrompt creates a new prompt using the typed request/response pattern
+func createPrompt(ctx context.Context, c *litellm.Client, request *PromptRequest) (*PromptSpec, error) {
+	response, err := litellm.SendRequestTyped[PromptRequest, PromptSpec](
+		ctx, c, http.MethodPost, "/prompts", request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prompt: %w", err)
+	}
+
+	return response, nil
+}
+
+// getPrompt retrieves a prompt by prompt ID.
+// It returns nil without an error when the prompt does not exist.
+func getPrompt(ctx context.Context, c *litellm.Client, promptID string) (*PromptSpec, error) {
+	response, err := litellm.SendRequestTyped[interface{}, PromptInfoResponse](
+		ctx, c, http.MethodGet, fmt.Sprintf("/prompts/%s", url.PathEscape(promptID)), nil,
+	)
+	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get prompt: %w", err)
+	}
+
+	return response.PromptSpec, nil
+}
+
+// updatePrompt replaces an existing prompt definition
+func updatePrompt(ctx context.Context, c *litellm.Client, request *PromptRequest) (*PromptSpec, error) {
+	response, err := litellm.SendRequestTyped[PromptRequest, PromptSpec](
+		ctx, c, http.MethodPut, fmt.Sprintf("/prompts/%s", url.PathEscape(request.PromptID)), request,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update prompt: %w", err)
+	}
+
+	return response, nil
+}
+
+// deletePrompt deletes a prompt by prompt ID
+func deletePrompt(ctx context.Context, c *litellm.Client, promptID string) error {
+	_, err := litellm.SendRequestTyped[interface{}, interface{}](
+		ctx, c, http.MethodDelete, fmt.Sprintf("/prompts/%s", url.PathEscape(promptID)), nil,
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete prompt: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package prompt
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// resourceGetter is implemented by both schema.ResourceData and schema.ResourceDiff,
+// so the prompt content can be rendered the same way at plan and apply time
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+// buildPromptRequest builds a PromptRequest from Terraform resource data
+func buildPromptRequest(d *schema.ResourceData) (*PromptRequest, error) {
+	content, err := buildPromptContent(d)
+	if err != nil {
+		return nil, err
+	}
+
+	promptID := d.Get("prompt_id").(string)
+
+	return &PromptRequest{
+		PromptID: promptID,
+		LiteLLMParams: PromptLiteLLMParams{
+			PromptID:          promptID,
+			PromptIntegration: d.Get("prompt_integration").(string),
+			DotpromptContent:  utils.StringPtr(content),
+		},
+		PromptInfo: PromptInfo{
+			PromptType: "db",
+		},
+	}, nil
+}
+
+// buildPromptContent reads the template from the configuration or template file
+// and renders the model and parameter defaults into its frontmatter
+func buildPromptContent(d resourceGetter) (string, error) {
+	template := d.Get("template").(string)
+	if path := d.Get("template_file").(string); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read template file %s: %w", path, err)
+		}
+		template = string(data)
+	}
+
+	return renderPromptContent(template, d.Get("model").(string), d.Get("parameters").(map[string]interface{}))
+}
+
+// renderPromptContent prepends a dotprompt frontmatter block with the model and parameters to the template.
+// The template is returned unchanged when no defaults are set.
+func renderPromptContent(template, model string, parameters map[string]interface{}) (string, error) {
+	if model == "" && len(parameters) == 0 {
+		return template, nil
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(template), "---") {
+		return "", fmt.Errorf("template already contains a frontmatter block; set model and parameters there instead of in the resource")
+	}
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	if model != "" {
+		fmt.Fprintf(&b, "model: %s\n", yamlScalar(model))
+	}
+
+	keys := make([]string, 0, len(parameters))
+	for k := range parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s: %s\n", k, yamlScalar(fmt.Sprintf("%v", parameters[k])))
+	}
+
+	b.WriteString("---\n")
+	b.WriteString(template)
+
+	return b.String(), nil
+}
+
+// yamlScalar renders a frontmatter value, keeping booleans and numbers unquoted
+func yamlScalar(value string) string {
+	if value == "true" || value == "false" {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	return strconv.Quote(value)
+}
+
+// hashPromptContent returns the SHA-256 hash of the prompt content.
+// Surrounding whitespace is ignored so that normalisation by the API does not produce a diff.
+func hashPromptContent(content string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(content)))
+	return hex.EncodeToString(sum[:])
+}
+
+// setPromptResourceData sets Terraform resource data from a PromptSpec
+func setPromptResourceData(d *schema.ResourceData, prompt *PromptSpec) error {
+	fields := map[string]interface{}{
+		"prompt_id":          prompt.PromptID,
+		"prompt_integration": prompt.LiteLLMParams.PromptIntegration,
+		"created_at":         prompt.CreatedAt,
+		"updated_at":         prompt.UpdatedAt,
+	}
+
+	for field, value := range fields {
+		// Use SetIfNotZero to preserve existing values when API doesn't return them
+		utils.SetIfNotZero(d, field, value)
+	}
+
+	// The template itself is not read back; drift is detected through the content hash
+	if prompt.LiteLLMParams.DotpromptContent != nil {
+		if err := d.Set("content_hash", hashPromptContent(*prompt.LiteLLMParams.DotpromptContent)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
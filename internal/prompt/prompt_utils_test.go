@@ -0,0 +1,111 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRenderPromptContent(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   string
+		model      string
+		parameters map[string]interface{}
+		expected   string
+		wantErr    bool
+	}{
+		{
+			name:     "template without defaults",
+			template: "Hello {{name}}",
+			expected: "Hello {{name}}",
+		},
+		{
+			name:     "model and parameters",
+			template: "Hello {{name}}",
+			model:    "gpt-4o",
+			parameters: map[string]interface{}{
+				"temperature": "0.7",
+				"max_tokens":  "500",
+				"stream":      "false",
+				"stop":        "END",
+			},
+			expected: "---\nmodel: \"gpt-4o\"\nmax_tokens: 500\nstop: \"END\"\nstream: false\ntemperature: 0.7\n---\nHello {{name}}",
+		},
+		{
+			name:     "template with existing frontmatter",
+			template: "---\nmodel: gpt-4o\n---\nHello",
+			model:    "gpt-4o-mini",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := renderPromptContent(tt.template, tt.model, tt.parameters)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("renderPromptContent() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("renderPromptContent() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHashPromptContentIgnoresSurroundingWhitespace(t *testing.T) {
+	if hashPromptContent("Hello {{name}}\n") != hashPromptContent("Hello {{name}}") {
+		t.Error("Expected trailing newline not to change the content hash")
+	}
+	if hashPromptContent("Hello {{name}}") == hashPromptContent("Hi {{name}}") {
+		t.Error("Expected different content to produce different hashes")
+	}
+}
+
+func TestBuildPromptRequestFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "greeting.prompt")
+	if err := os.WriteFile(path, []byte("Hello {{name}}\n"), 0o600); err != nil {
+		t.Fatalf("failed to write template file: %v", err)
+	}
+
+	resource := ResourcePrompt()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"prompt_id":     "greeting",
+		"template_file": path,
+	})
+
+	request, err := buildPromptRequest(d)
+	if err != nil {
+		t.Fatalf("buildPromptRequest() unexpected error: %v", err)
+	}
+
+	if request.PromptID != "greeting" || request.LiteLLMParams.PromptID != "greeting" {
+		t.Errorf("Expected prompt_id 'greeting', got %q / %q", request.PromptID, request.LiteLLMParams.PromptID)
+	}
+	if request.LiteLLMParams.PromptIntegration != "dotprompt" {
+		t.Errorf("Expected prompt_integration 'dotprompt', got %q", request.LiteLLMParams.PromptIntegration)
+	}
+	if request.LiteLLMParams.DotpromptContent == nil || *request.LiteLLMParams.DotpromptContent != "Hello {{name}}\n" {
+		t.Errorf("Expected template content from file, got %v", request.LiteLLMParams.DotpromptContent)
+	}
+}
+
+func TestBuildPromptRequestMissingFile(t *testing.T) {
+	resource := ResourcePrompt()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"prompt_id":     "greeting",
+		"template_file": filepath.Join(t.TempDir(), "missing.prompt"),
+	})
+
+	if _, err := buildPromptRequest(d); err == nil {
+		t.Fatal("Expected an error for a missing template file")
+	}
+}
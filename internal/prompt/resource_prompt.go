@@ -0,0 +1,125 @@
+package prompt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourcePrompt defines the schema for the LiteLLM prompt resource.
+func ResourcePrompt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePromptCreate,
+		ReadContext:   resourcePromptRead,
+		UpdateContext: resourcePromptUpdate,
+		DeleteContext: resourcePromptDelete,
+		CustomizeDiff: resourcePromptCustomizeDiff,
+		Importer:      PromptImporter(),
+		Schema:        resourcePromptSchema(),
+	}
+}
+
+// resourcePromptCreate creates a new prompt in LiteLLM.
+func resourcePromptCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Creating LiteLLM prompt", map[string]interface{}{"prompt_id": d.Get("prompt_id")})
+
+	client := m.(*litellm.Client)
+
+	request, err := buildPromptRequest(d)
+	if err != nil {
+		return diag.Errorf("error building prompt: %v", err)
+	}
+
+	if _, err := createPrompt(ctx, client, request); err != nil {
+		return diag.Errorf("error creating prompt: %v", err)
+	}
+
+	d.SetId(request.PromptID)
+	tflog.Info(ctx, "Created prompt", map[string]interface{}{"prompt_id": request.PromptID})
+
+	return resourcePromptRead(ctx, d, m)
+}
+
+// resourcePromptRead reads the current state of a prompt from LiteLLM.
+func resourcePromptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM prompt", map[string]interface{}{"prompt_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	promptResp, err := getPrompt(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error reading prompt: %v", err)
+	}
+
+	if promptResp == nil {
+		tflog.Warn(ctx, "Prompt not found, removing from state", map[string]interface{}{"prompt_id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := setPromptResourceData(d, promptResp); err != nil {
+		return diag.Errorf("error setting prompt data: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully read prompt", map[string]interface{}{"prompt_id": d.Id()})
+	return nil
+}
+
+// resourcePromptUpdate updates an existing prompt in LiteLLM.
+func resourcePromptUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM prompt", map[string]interface{}{"prompt_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	// The update endpoint replaces the whole prompt, so the full request is sent
+	request, err := buildPromptRequest(d)
+	if err != nil {
+		return diag.Errorf("error building prompt: %v", err)
+	}
+
+	if _, err := updatePrompt(ctx, client, request); err != nil {
+		return diag.Errorf("error updating prompt: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully updated prompt", map[string]interface{}{"prompt_id": d.Id()})
+	return resourcePromptRead(ctx, d, m)
+}
+
+// resourcePromptDelete deletes a prompt from LiteLLM.
+func resourcePromptDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM prompt", map[string]interface{}{"prompt_id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if err := deletePrompt(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error deleting prompt: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully deleted prompt", map[string]interface{}{"prompt_id": d.Id()})
+	return nil
+}
+
+// resourcePromptCustomizeDiff plans a content_hash change whenever the rendered prompt content differs
+// from what is stored in LiteLLM, including edits to template_file that Terraform cannot see otherwise.
+func resourcePromptCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, field := range []string{"template", "template_file", "model", "parameters"} {
+		if !d.NewValueKnown(field) {
+			return d.SetNewComputed("content_hash")
+		}
+	}
+
+	content, err := buildPromptContent(d)
+	if err != nil {
+		return err
+	}
+
+	if hash := hashPromptContent(content); d.Get("content_hash").(string) != hash {
+		tflog.Debug(ctx, "Prompt content changed", map[string]interface{}{"prompt_id": d.Get("prompt_id")})
+		return d.SetNew("content_hash", hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package prompt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourcePromptSchema returns the schema for the prompt resource
+func resourcePromptSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"prompt_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Unique identifier of the prompt, as referenced from the prompts list of keys, users and teams",
+		},
+		"prompt_integration": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "dotprompt",
+			Description: "Prompt management integration that renders the prompt. Defaults to 'dotprompt'.",
+		},
+		"template": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"template", "template_file"},
+			Description:  "Dotprompt template content",
+		},
+		"template_file": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"template", "template_file"},
+			Description:  "Path to a file containing the dotprompt template. Changes to the file content are detected through content_hash.",
+		},
+		"model": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Default model for the prompt, written to the template's frontmatter",
+		},
+		"parameters": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Default model parameters for the prompt such as temperature or max_tokens, written to the template's frontmatter",
+		},
+		// Computed fields
+		"content_hash": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SHA-256 hash of the prompt content stored in LiteLLM",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the prompt was created",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the prompt was last updated",
+		},
+	}
+}
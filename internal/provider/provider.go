@@ -4,16 +4,31 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/cloudzero"
+	"github.com/scalepad/terraform-provider-litellm/internal/customer"
+	"github.com/scalepad/terraform-provider-litellm/internal/guardrail"
 	"github.com/scalepad/terraform-provider-litellm/internal/key"
 	"github.com/scalepad/terraform-provider-litellm/internal/key/service-account"
 	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
 	"github.com/scalepad/terraform-provider-litellm/internal/models"
 	"github.com/scalepad/terraform-provider-litellm/internal/models/creds"
+	"github.com/scalepad/terraform-provider-litellm/internal/models/hub"
+	"github.com/scalepad/terraform-provider-litellm/internal/organization"
+	orgmember "github.com/scalepad/terraform-provider-litellm/internal/organization/member"
+	"github.com/scalepad/terraform-provider-litellm/internal/passthrough"
+	"github.com/scalepad/terraform-provider-litellm/internal/prompt"
+	"github.com/scalepad/terraform-provider-litellm/internal/settings"
+	"github.com/scalepad/terraform-provider-litellm/internal/tag"
 	"github.com/scalepad/terraform-provider-litellm/internal/team"
+	"github.com/scalepad/terraform-provider-litellm/internal/team/callback"
 	"github.com/scalepad/terraform-provider-litellm/internal/team/member"
+	teammodel "github.com/scalepad/terraform-provider-litellm/internal/team/model"
 	"github.com/scalepad/terraform-provider-litellm/internal/tools/mcp"
 	"github.com/scalepad/terraform-provider-litellm/internal/tools/vector"
 	"github.com/scalepad/terraform-provider-litellm/internal/users"
@@ -23,20 +38,42 @@ import (
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"litellm_model":           models.ResourceModel(),
-			"litellm_team":            team.ResourceTeam(),
-			"litellm_team_member":     member.ResourceTeamMember(),
-			"litellm_team_member_add": member.ResourceTeamMemberAdd(),
-			"litellm_key":             key.ResourceKey(),
-			"litellm_service_account": serviceaccount.ResourceServiceAccount(),
-			"litellm_mcp_server":      mcp.ResourceLiteLLMMCPServer(),
-			"litellm_credential":      creds.ResourceCredential(),
-			"litellm_vector_store":    vector.ResourceLiteLLMVectorStore(),
-			"litellm_user":            users.ResourceUser(),
+			"litellm_model":                  models.ResourceModel(),
+			"litellm_team":                   team.ResourceTeam(),
+			"litellm_team_member":            member.ResourceTeamMember(),
+			"litellm_team_member_add":        member.ResourceTeamMemberAdd(),
+			"litellm_key":                    key.ResourceKey(),
+			"litellm_service_account":        serviceaccount.ResourceServiceAccount(),
+			"litellm_mcp_server":             mcp.ResourceLiteLLMMCPServer(),
+			"litellm_credential":             creds.ResourceCredential(),
+			"litellm_vector_store":           vector.ResourceLiteLLMVectorStore(),
+			"litellm_user":                   users.ResourceUser(),
+			"litellm_organization":           organization.ResourceOrganization(),
+			"litellm_organization_member":    orgmember.ResourceOrganizationMember(),
+			"litellm_budget":                 budget.ResourceBudget(),
+			"litellm_customer":               customer.ResourceCustomer(),
+			"litellm_guardrail":              guardrail.ResourceGuardrail(),
+			"litellm_prompt":                 prompt.ResourcePrompt(),
+			"litellm_tag":                    tag.ResourceTag(),
+			"litellm_pass_through_endpoint":  passthrough.ResourcePassThroughEndpoint(),
+			"litellm_sso_settings":           settings.ResourceSSOSettings(),
+			"litellm_default_team_settings":  settings.ResourceDefaultTeamSettings(),
+			"litellm_internal_user_settings": settings.ResourceInternalUserSettings(),
+			"litellm_team_callback":          callback.ResourceTeamCallback(),
+			"litellm_allowed_ip":             settings.ResourceAllowedIP(),
+			"litellm_ui_theme_settings":      settings.ResourceUIThemeSettings(),
+			"litellm_email_event_settings":   settings.ResourceEmailEventSettings(),
+			"litellm_cloudzero_settings":     cloudzero.ResourceCloudZeroSettings(),
+			"litellm_model_group_visibility": hub.ResourceModelGroupVisibility(),
+			"litellm_team_model":             teammodel.ResourceTeamModel(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"litellm_credential":   creds.DataSourceLiteLLMCredential(),
-			"litellm_vector_store": vector.DataSourceLiteLLMVectorStore(),
+			"litellm_credential":        creds.DataSourceLiteLLMCredential(),
+			"litellm_vector_store":      vector.DataSourceLiteLLMVectorStore(),
+			"litellm_guardrails":        guardrail.DataSourceLiteLLMGuardrails(),
+			"litellm_provider_budgets":  budget.DataSourceLiteLLMProviderBudgets(),
+			"litellm_mcp_access_groups": mcp.DataSourceLiteLLMMCPAccessGroups(),
+			"litellm_tags":              tag.DataSourceLiteLLMTags(),
 		},
 		Schema: map[string]*schema.Schema{
 			"api_base": {
@@ -59,6 +96,20 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: "Skip TLS certificate verification when connecting to the LiteLLM API",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      litellm.DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of retries for failed requests. GET requests are retried on connection errors and 429, 502, 503 and 504 responses; other requests only when the connection failed before the request was sent",
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(litellm.DefaultRetryMaxWait / time.Second),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum wait in seconds between two attempts, including waits requested by a Retry-After header",
+			},
 		},
 		ConfigureContextFunc: providerConfigureContext,
 	}
@@ -70,9 +121,13 @@ func providerConfigureContext(ctx context.Context, d *schema.ResourceData) (inte
 		APIBase:            d.Get("api_base").(string),
 		APIKey:             d.Get("api_key").(string),
 		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		MaxRetries:         d.Get("max_retries").(int),
+		RetryMaxWait:       time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
 	}
 
 	client := litellm.NewClient(config.APIBase, config.APIKey, config.InsecureSkipVerify)
+	client.MaxRetries = config.MaxRetries
+	client.RetryMaxWait = config.RetryMaxWait
 
 	// Test the connection by calling GET /models
 	if err := testConnection(ctx, client); err != nil {
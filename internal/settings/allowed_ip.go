@@ -0,0 +1,14 @@
+package settings
+
+import "encoding/json"
+
+// AllowedIPRequest represents a request to add or remove an IP from the proxy allowlist
+type AllowedIPRequest struct {
+	IP string `json:"ip"`
+}
+
+// AllowedIPsResponse represents the response of GET /get/allowed_ips. Data is null until the first
+// IP is added, and is kept raw so a response without the field can be told apart from an empty allowlist.
+type AllowedIPsResponse struct {
+	Data json.RawMessage `json:"data"`
+}
@@ -0,0 +1,56 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// addAllowedIP adds an IP or CIDR to the proxy allowlist
+func addAllowedIP(ctx context.Context, c *litellm.Client, ip string) error {
+	_, err := litellm.SendRequestTyped[AllowedIPRequest, interface{}](
+		ctx, c, http.MethodPost, "/add/allowed_ip", &AllowedIPRequest{IP: ip},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add allowed IP: %w", err)
+	}
+
+	return nil
+}
+
+// hasAllowedIP reports whether an IP or CIDR is on the proxy allowlist
+func hasAllowedIP(ctx context.Context, c *litellm.Client, ip string) (bool, error) {
+	response, err := litellm.SendRequestTyped[interface{}, AllowedIPsResponse](
+		ctx, c, http.MethodGet, "/get/allowed_ips", nil,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to get allowed IPs: %w", err)
+	}
+
+	if response.Data == nil {
+		return false, fmt.Errorf("failed to get allowed IPs: response has no data field")
+	}
+
+	var allowedIPs []string
+	if err := json.Unmarshal(response.Data, &allowedIPs); err != nil {
+		return false, fmt.Errorf("failed to decode allowed IPs: %w", err)
+	}
+
+	return slices.Contains(allowedIPs, ip), nil
+}
+
+// deleteAllowedIP removes an IP or CIDR from the proxy allowlist
+func deleteAllowedIP(ctx context.Context, c *litellm.Client, ip string) error {
+	_, err := litellm.SendRequestTyped[AllowedIPRequest, interface{}](
+		ctx, c, http.MethodPost, "/delete/allowed_ip", &AllowedIPRequest{IP: ip},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete allowed IP: %w", err)
+	}
+
+	return nil
+}
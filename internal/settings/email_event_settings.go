@@ -0,0 +1,19 @@
+package settings
+
+// emailEvents maps the schema fields of litellm_email_event_settings to the LiteLLM email event names.
+// These are the events of the EmailEvent enum in the LiteLLM API.
+var emailEvents = map[string]string{
+	"virtual_key_created": "Virtual Key Created",
+	"new_user_invitation": "New User Invitation",
+}
+
+// EmailEventSetting represents whether emails are sent for a single event
+type EmailEventSetting struct {
+	Event   string `json:"event"`
+	Enabled bool   `json:"enabled"`
+}
+
+// EmailEventSettings represents the request and response of the /email/event_settings endpoint
+type EmailEventSettings struct {
+	Settings []EmailEventSetting `json:"settings"`
+}
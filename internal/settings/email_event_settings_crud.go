@@ -0,0 +1,45 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// getEmailEventSettings retrieves the email event settings
+func getEmailEventSettings(ctx context.Context, c *litellm.Client) (*EmailEventSettings, error) {
+	response, err := litellm.SendRequestTyped[interface{}, EmailEventSettings](
+		ctx, c, http.MethodGet, "/email/event_settings", nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email event settings: %w", err)
+	}
+
+	return response, nil
+}
+
+// updateEmailEventSettings enables or disables emails for the given events
+func updateEmailEventSettings(ctx context.Context, c *litellm.Client, request *EmailEventSettings) error {
+	_, err := litellm.SendRequestTyped[EmailEventSettings, interface{}](
+		ctx, c, http.MethodPatch, "/email/event_settings", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update email event settings: %w", err)
+	}
+
+	return nil
+}
+
+// resetEmailEventSettings restores the default email event settings
+func resetEmailEventSettings(ctx context.Context, c *litellm.Client) error {
+	_, err := litellm.SendRequestTyped[interface{}, interface{}](
+		ctx, c, http.MethodPost, "/email/event_settings/reset", nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reset email event settings: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SettingsImporter provides import functionality for LiteLLM settings resources.
+// Settings are singletons, so the import ID is expected to be the settings name, e.g. sso_settings.
+// Allowlist entries use the IP or CIDR as the import ID.
+func SettingsImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	}
+}
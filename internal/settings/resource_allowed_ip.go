@@ -0,0 +1,74 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceAllowedIP defines the schema for a single entry of the LiteLLM proxy IP allowlist.
+func ResourceAllowedIP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAllowedIPCreate,
+		ReadContext:   resourceAllowedIPRead,
+		DeleteContext: resourceAllowedIPDelete,
+		Importer:      SettingsImporter(),
+		Schema:        resourceAllowedIPSchema(),
+	}
+}
+
+// resourceAllowedIPCreate adds an IP to the proxy allowlist.
+func resourceAllowedIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellm.Client)
+	ip := d.Get("ip").(string)
+
+	tflog.Info(ctx, "Adding LiteLLM allowed IP", map[string]interface{}{"ip": ip})
+
+	if err := addAllowedIP(ctx, client, ip); err != nil {
+		return diag.Errorf("error adding allowed IP: %v", err)
+	}
+
+	d.SetId(ip)
+
+	return resourceAllowedIPRead(ctx, d, m)
+}
+
+// resourceAllowedIPRead checks that the IP is still on the proxy allowlist.
+func resourceAllowedIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM allowed IP", map[string]interface{}{"ip": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	found, err := hasAllowedIP(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error reading allowed IP: %v", err)
+	}
+
+	if !found {
+		tflog.Warn(ctx, "Allowed IP not found, removing from state", map[string]interface{}{"ip": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("ip", d.Id()); err != nil {
+		return diag.Errorf("error setting allowed IP data: %v", err)
+	}
+
+	return nil
+}
+
+// resourceAllowedIPDelete removes an IP from the proxy allowlist.
+func resourceAllowedIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM allowed IP", map[string]interface{}{"ip": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if err := deleteAllowedIP(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error deleting allowed IP: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAllowedIPSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+			Description:  "IP address or CIDR range allowed to reach the proxy",
+		},
+	}
+}
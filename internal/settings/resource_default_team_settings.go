@@ -0,0 +1,70 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceDefaultTeamSettings defines the schema for the LiteLLM default team settings singleton resource.
+func ResourceDefaultTeamSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDefaultTeamSettingsWrite,
+		ReadContext:   resourceDefaultTeamSettingsRead,
+		UpdateContext: resourceDefaultTeamSettingsWrite,
+		DeleteContext: resourceDefaultTeamSettingsDelete,
+		Importer:      SettingsImporter(),
+		Schema:        resourceDefaultTeamSettingsSchema(),
+	}
+}
+
+// resourceDefaultTeamSettingsWrite writes the default team settings. Create and update are the same operation on a singleton.
+func resourceDefaultTeamSettingsWrite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Writing LiteLLM default team settings")
+
+	client := m.(*litellm.Client)
+
+	settings := buildDefaultTeamSettings(d)
+
+	if err := updateSettings(ctx, client, defaultTeamSettingsPath, settings); err != nil {
+		return diag.Errorf("error writing default team settings: %v", err)
+	}
+
+	d.SetId(defaultTeamSettingsPath)
+
+	return resourceDefaultTeamSettingsRead(ctx, d, m)
+}
+
+// resourceDefaultTeamSettingsRead reads the default team settings from LiteLLM.
+func resourceDefaultTeamSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM default team settings")
+
+	client := m.(*litellm.Client)
+
+	settings, err := getSettings[DefaultTeamSettings](ctx, client, defaultTeamSettingsPath)
+	if err != nil {
+		return diag.Errorf("error reading default team settings: %v", err)
+	}
+
+	if err := setDefaultTeamSettingsResourceData(d, settings); err != nil {
+		return diag.Errorf("error setting default team settings data: %v", err)
+	}
+
+	return nil
+}
+
+// resourceDefaultTeamSettingsDelete resets the default team settings to the LiteLLM defaults.
+func resourceDefaultTeamSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Resetting LiteLLM default team settings")
+
+	client := m.(*litellm.Client)
+
+	if err := updateSettings(ctx, client, defaultTeamSettingsPath, defaultDefaultTeamSettings()); err != nil {
+		return diag.Errorf("error resetting default team settings: %v", err)
+	}
+
+	return nil
+}
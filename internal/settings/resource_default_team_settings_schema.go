@@ -0,0 +1,43 @@
+package settings
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDefaultTeamSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"models": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Models new teams have access to",
+		},
+		"max_budget": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Maximum budget for new teams",
+		},
+		"budget_duration": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(\d+[smhd])$`),
+				"Budget duration must be in format: number followed by 's' (seconds), 'm' (minutes), 'h' (hours), or 'd' (days). Examples: '30s', '30m', '30h', '30d'",
+			),
+			Description: "Budget reset period for new teams. You can set duration as seconds ('30s'), minutes ('30m'), hours ('30h'), days ('30d').",
+		},
+		"tpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Tokens per minute limit for new teams",
+		},
+		"rpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Requests per minute limit for new teams",
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+const emailEventSettingsID = "email_event_settings"
+
+// ResourceEmailEventSettings defines the schema for the LiteLLM email event settings singleton resource.
+func ResourceEmailEventSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailEventSettingsWrite,
+		ReadContext:   resourceEmailEventSettingsRead,
+		UpdateContext: resourceEmailEventSettingsWrite,
+		DeleteContext: resourceEmailEventSettingsDelete,
+		Importer:      SettingsImporter(),
+		Schema:        resourceEmailEventSettingsSchema(),
+	}
+}
+
+// resourceEmailEventSettingsWrite writes the configured event toggles. Create and update are the same operation on a singleton.
+func resourceEmailEventSettingsWrite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Writing LiteLLM email event settings")
+
+	client := m.(*litellm.Client)
+
+	if request := buildEmailEventSettings(d); len(request.Settings) > 0 {
+		if err := updateEmailEventSettings(ctx, client, request); err != nil {
+			return diag.Errorf("error writing email event settings: %v", err)
+		}
+	}
+
+	d.SetId(emailEventSettingsID)
+
+	return resourceEmailEventSettingsRead(ctx, d, m)
+}
+
+// resourceEmailEventSettingsRead reads the email event settings from LiteLLM.
+func resourceEmailEventSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM email event settings")
+
+	client := m.(*litellm.Client)
+
+	settings, err := getEmailEventSettings(ctx, client)
+	if err != nil {
+		return diag.Errorf("error reading email event settings: %v", err)
+	}
+
+	if err := setEmailEventSettingsResourceData(d, settings); err != nil {
+		return diag.Errorf("error setting email event settings data: %v", err)
+	}
+
+	return nil
+}
+
+// resourceEmailEventSettingsDelete restores the default email event settings.
+func resourceEmailEventSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Resetting LiteLLM email event settings")
+
+	client := m.(*litellm.Client)
+
+	if err := resetEmailEventSettings(ctx, client); err != nil {
+		return diag.Errorf("error resetting email event settings: %v", err)
+	}
+
+	return nil
+}
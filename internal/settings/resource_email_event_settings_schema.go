@@ -0,0 +1,20 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEmailEventSettingsSchema() map[string]*schema.Schema {
+	s := make(map[string]*schema.Schema, len(emailEvents))
+
+	for field, event := range emailEvents {
+		s[field] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Send an email for the '" + event + "' event. Left to the LiteLLM default when not set.",
+		}
+	}
+
+	return s
+}
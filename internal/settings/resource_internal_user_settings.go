@@ -0,0 +1,70 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceInternalUserSettings defines the schema for the LiteLLM internal user settings singleton resource.
+func ResourceInternalUserSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInternalUserSettingsWrite,
+		ReadContext:   resourceInternalUserSettingsRead,
+		UpdateContext: resourceInternalUserSettingsWrite,
+		DeleteContext: resourceInternalUserSettingsDelete,
+		Importer:      SettingsImporter(),
+		Schema:        resourceInternalUserSettingsSchema(),
+	}
+}
+
+// resourceInternalUserSettingsWrite writes the internal user settings. Create and update are the same operation on a singleton.
+func resourceInternalUserSettingsWrite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Writing LiteLLM internal user settings")
+
+	client := m.(*litellm.Client)
+
+	settings := buildInternalUserSettings(d)
+
+	if err := updateSettings(ctx, client, internalUserSettingsPath, settings); err != nil {
+		return diag.Errorf("error writing internal user settings: %v", err)
+	}
+
+	d.SetId(internalUserSettingsPath)
+
+	return resourceInternalUserSettingsRead(ctx, d, m)
+}
+
+// resourceInternalUserSettingsRead reads the internal user settings from LiteLLM.
+func resourceInternalUserSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM internal user settings")
+
+	client := m.(*litellm.Client)
+
+	settings, err := getSettings[InternalUserSettings](ctx, client, internalUserSettingsPath)
+	if err != nil {
+		return diag.Errorf("error reading internal user settings: %v", err)
+	}
+
+	if err := setInternalUserSettingsResourceData(d, settings); err != nil {
+		return diag.Errorf("error setting internal user settings data: %v", err)
+	}
+
+	return nil
+}
+
+// resourceInternalUserSettingsDelete resets the internal user settings to the LiteLLM defaults.
+func resourceInternalUserSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Resetting LiteLLM internal user settings")
+
+	client := m.(*litellm.Client)
+
+	if err := updateSettings(ctx, client, internalUserSettingsPath, defaultInternalUserSettings()); err != nil {
+		return diag.Errorf("error resetting internal user settings: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package settings
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInternalUserSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_role": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"proxy_admin",
+				"proxy_admin_viewer",
+				"internal_user",
+				"internal_user_viewer",
+			}, false),
+			Description: "Role assigned to new internal users. Valid values: proxy_admin, proxy_admin_viewer, internal_user, internal_user_viewer",
+		},
+		"max_budget": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Maximum budget for new internal users",
+		},
+		"budget_duration": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(\d+[smhd])$`),
+				"Budget duration must be in format: number followed by 's' (seconds), 'm' (minutes), 'h' (hours), or 'd' (days). Examples: '30s', '30m', '30h', '30d'",
+			),
+			Description: "Budget reset period for new internal users. You can set duration as seconds ('30s'), minutes ('30m'), hours ('30h'), days ('30d').",
+		},
+		"models": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Models new internal users have access to",
+		},
+	}
+}
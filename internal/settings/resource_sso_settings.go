@@ -0,0 +1,68 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceSSOSettings defines the schema for the LiteLLM SSO settings singleton resource.
+func ResourceSSOSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSSOSettingsWrite,
+		ReadContext:   resourceSSOSettingsRead,
+		UpdateContext: resourceSSOSettingsWrite,
+		DeleteContext: resourceSSOSettingsDelete,
+		Importer:      SettingsImporter(),
+		Schema:        resourceSSOSettingsSchema(),
+	}
+}
+
+// resourceSSOSettingsWrite writes the SSO settings. Create and update are the same operation on a singleton.
+func resourceSSOSettingsWrite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Writing LiteLLM SSO settings")
+
+	client := m.(*litellm.Client)
+
+	if err := updateSettings(ctx, client, ssoSettingsPath, buildSSOSettings(d)); err != nil {
+		return diag.Errorf("error writing SSO settings: %v", err)
+	}
+
+	d.SetId(ssoSettingsPath)
+
+	return resourceSSOSettingsRead(ctx, d, m)
+}
+
+// resourceSSOSettingsRead reads the SSO settings from LiteLLM.
+func resourceSSOSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM SSO settings")
+
+	client := m.(*litellm.Client)
+
+	settings, err := getSettings[SSOSettings](ctx, client, ssoSettingsPath)
+	if err != nil {
+		return diag.Errorf("error reading SSO settings: %v", err)
+	}
+
+	if err := setSSOSettingsResourceData(d, settings); err != nil {
+		return diag.Errorf("error setting SSO settings data: %v", err)
+	}
+
+	return nil
+}
+
+// resourceSSOSettingsDelete clears the SSO settings, which disables SSO on the proxy.
+func resourceSSOSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Clearing LiteLLM SSO settings")
+
+	client := m.(*litellm.Client)
+
+	if err := updateSettings(ctx, client, ssoSettingsPath, &SSOSettings{}); err != nil {
+		return diag.Errorf("error clearing SSO settings: %v", err)
+	}
+
+	return nil
+}
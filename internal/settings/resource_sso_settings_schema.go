@@ -0,0 +1,80 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSSOSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"google_client_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Google OAuth client ID",
+		},
+		"google_client_secret": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Google OAuth client secret",
+		},
+		"microsoft_client_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Microsoft Entra ID client ID",
+		},
+		"microsoft_client_secret": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Microsoft Entra ID client secret",
+		},
+		"microsoft_tenant": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Microsoft Entra ID tenant ID",
+		},
+		"generic_client_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Client ID of a generic OAuth/OIDC provider",
+		},
+		"generic_client_secret": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Client secret of a generic OAuth/OIDC provider",
+		},
+		"generic_authorization_endpoint": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Authorization endpoint of the generic provider",
+		},
+		"generic_token_endpoint": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Token endpoint of the generic provider",
+		},
+		"generic_userinfo_endpoint": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Userinfo endpoint of the generic provider",
+		},
+		"proxy_base_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Public base URL of the proxy, used to build the SSO callback URL",
+		},
+		"user_email": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Email of the proxy admin user",
+		},
+		"ui_access_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"admin_only", "all"}, false),
+			Description:  "Who can sign in to the admin UI. Valid values: admin_only, all",
+		},
+	}
+}
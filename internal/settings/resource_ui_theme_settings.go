@@ -0,0 +1,133 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceUIThemeSettings defines the schema for the LiteLLM admin UI theme singleton resource.
+func ResourceUIThemeSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUIThemeSettingsWrite,
+		ReadContext:   resourceUIThemeSettingsRead,
+		UpdateContext: resourceUIThemeSettingsWrite,
+		DeleteContext: resourceUIThemeSettingsDelete,
+		CustomizeDiff: resourceUIThemeSettingsCustomizeDiff,
+		Importer:      SettingsImporter(),
+		Schema:        resourceUIThemeSettingsSchema(),
+	}
+}
+
+// resourceUIThemeSettingsWrite uploads the logo file when it changed and writes the theme settings.
+// Create and update are the same operation on a singleton.
+func resourceUIThemeSettingsWrite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Writing LiteLLM UI theme settings")
+
+	client := m.(*litellm.Client)
+
+	settings := buildUIThemeSettings(d)
+
+	if path := d.Get("logo_file").(string); path != "" {
+		hash, err := hashLogoFile(path)
+		if err != nil {
+			return diag.Errorf("error reading logo file: %v", err)
+		}
+
+		if d.IsNewResource() || d.HasChange("logo_file_hash") {
+			tflog.Info(ctx, "Uploading LiteLLM UI logo", map[string]interface{}{"logo_file": path})
+
+			if err := uploadLogo(ctx, client, path); err != nil {
+				return diag.Errorf("error uploading logo: %v", err)
+			}
+		}
+
+		// The upload sets logo_url on the server, so write it back unchanged
+		current, err := getSettings[UIThemeSettings](ctx, client, uiThemeSettingsPath)
+		if err != nil {
+			return diag.Errorf("error reading UI theme settings: %v", err)
+		}
+		settings.LogoURL = current.LogoURL
+
+		if err := d.Set("logo_file_hash", hash); err != nil {
+			return diag.Errorf("error setting UI theme settings data: %v", err)
+		}
+	} else if err := d.Set("logo_file_hash", ""); err != nil {
+		return diag.Errorf("error setting UI theme settings data: %v", err)
+	}
+
+	if err := updateSettings(ctx, client, uiThemeSettingsPath, settings); err != nil {
+		return diag.Errorf("error writing UI theme settings: %v", err)
+	}
+
+	d.SetId(uiThemeSettingsPath)
+
+	return resourceUIThemeSettingsRead(ctx, d, m)
+}
+
+// resourceUIThemeSettingsRead reads the UI theme settings from LiteLLM.
+func resourceUIThemeSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM UI theme settings")
+
+	client := m.(*litellm.Client)
+
+	settings, err := getSettings[UIThemeSettings](ctx, client, uiThemeSettingsPath)
+	if err != nil {
+		return diag.Errorf("error reading UI theme settings: %v", err)
+	}
+
+	if err := setUIThemeSettingsResourceData(d, settings); err != nil {
+		return diag.Errorf("error setting UI theme settings data: %v", err)
+	}
+
+	return nil
+}
+
+// resourceUIThemeSettingsDelete removes the logo override so the admin UI falls back to the default branding.
+func resourceUIThemeSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Resetting LiteLLM UI theme settings")
+
+	client := m.(*litellm.Client)
+
+	if err := updateSettings(ctx, client, uiThemeSettingsPath, &UIThemeSettings{}); err != nil {
+		return diag.Errorf("error resetting UI theme settings: %v", err)
+	}
+
+	return nil
+}
+
+// resourceUIThemeSettingsCustomizeDiff plans a logo_file_hash change whenever the content of logo_file
+// changes, which Terraform cannot see from the path alone.
+func resourceUIThemeSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("logo_file") {
+		if err := d.SetNewComputed("logo_file_hash"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("logo_url")
+	}
+
+	hash := ""
+	if path := d.Get("logo_file").(string); path != "" {
+		var err error
+		if hash, err = hashLogoFile(path); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("logo_file_hash").(string) == hash {
+		return nil
+	}
+
+	tflog.Debug(ctx, "UI logo file changed")
+	if err := d.SetNew("logo_file_hash", hash); err != nil {
+		return err
+	}
+	if hash != "" {
+		// The uploaded logo is served from a new URL
+		return d.SetNewComputed("logo_url")
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUIThemeSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"logo_url": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"logo_file"},
+			Description:   "URL of the logo shown in the admin UI",
+		},
+		"logo_file": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"logo_url"},
+			Description:   "Path to a local image file uploaded as the admin UI logo",
+		},
+		"logo_file_hash": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SHA-256 hash of the uploaded logo file, used to detect changes to the file",
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package settings
+
+// SettingsResponse represents the response of the /get/*_settings endpoints
+type SettingsResponse[T any] struct {
+	Values T `json:"values"`
+}
+
+// SSOSettings represents the SSO configuration of the proxy
+type SSOSettings struct {
+	GoogleClientID               *string `json:"google_client_id"`
+	GoogleClientSecret           *string `json:"google_client_secret"`
+	MicrosoftClientID            *string `json:"microsoft_client_id"`
+	MicrosoftClientSecret        *string `json:"microsoft_client_secret"`
+	MicrosoftTenant              *string `json:"microsoft_tenant"`
+	GenericClientID              *string `json:"generic_client_id"`
+	GenericClientSecret          *string `json:"generic_client_secret"`
+	GenericAuthorizationEndpoint *string `json:"generic_authorization_endpoint"`
+	GenericTokenEndpoint         *string `json:"generic_token_endpoint"`
+	GenericUserinfoEndpoint      *string `json:"generic_userinfo_endpoint"`
+	ProxyBaseURL                 *string `json:"proxy_base_url"`
+	UserEmail                    *string `json:"user_email"`
+	UIAccessMode                 *string `json:"ui_access_mode"`
+}
+
+// DefaultTeamSettings represents the defaults applied to teams created through SSO
+type DefaultTeamSettings struct {
+	Models         []string `json:"models"`
+	MaxBudget      *float64 `json:"max_budget"`
+	BudgetDuration *string  `json:"budget_duration"`
+	TPMLimit       *int     `json:"tpm_limit"`
+	RPMLimit       *int     `json:"rpm_limit"`
+}
+
+// InternalUserSettings represents the defaults applied to new internal users
+type InternalUserSettings struct {
+	UserRole       *string  `json:"user_role"`
+	MaxBudget      *float64 `json:"max_budget"`
+	BudgetDuration *string  `json:"budget_duration"`
+	Models         []string `json:"models"`
+}
+
+// UIThemeSettings represents the branding of the admin UI
+type UIThemeSettings struct {
+	LogoURL *string `json:"logo_url"`
+}
@@ -0,0 +1,62 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+const (
+	ssoSettingsPath          = "sso_settings"
+	defaultTeamSettingsPath  = "default_team_settings"
+	internalUserSettingsPath = "internal_user_settings"
+	uiThemeSettingsPath      = "ui_theme_settings"
+)
+
+// getSettings reads a settings singleton through its /get/<name> endpoint
+func getSettings[T any](ctx context.Context, c *litellm.Client, name string) (*T, error) {
+	response, err := litellm.SendRequestTyped[interface{}, SettingsResponse[T]](
+		ctx, c, http.MethodGet, "/get/"+name, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", name, err)
+	}
+
+	return &response.Values, nil
+}
+
+// updateSettings writes a settings singleton through its /update/<name> endpoint
+func updateSettings[T any](ctx context.Context, c *litellm.Client, name string, settings *T) error {
+	_, err := litellm.SendRequestTyped[T, interface{}](
+		ctx, c, http.MethodPatch, "/update/"+name, settings,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// uploadLogo uploads a local image file as the admin UI logo
+func uploadLogo(ctx context.Context, c *litellm.Client, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open logo file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	_, err = litellm.SendMultipartRequestTyped[interface{}](
+		ctx, c, http.MethodPost, "/upload/logo", nil, []litellm.MultipartFile{
+			{FieldName: "file", FileName: filepath.Base(path), Content: file},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upload logo: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package settings
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// buildSSOSettings builds SSOSettings from Terraform resource data
+func buildSSOSettings(d *schema.ResourceData) *SSOSettings {
+	return &SSOSettings{
+		GoogleClientID:               optionalString(d, "google_client_id"),
+		GoogleClientSecret:           optionalString(d, "google_client_secret"),
+		MicrosoftClientID:            optionalString(d, "microsoft_client_id"),
+		MicrosoftClientSecret:        optionalString(d, "microsoft_client_secret"),
+		MicrosoftTenant:              optionalString(d, "microsoft_tenant"),
+		GenericClientID:              optionalString(d, "generic_client_id"),
+		GenericClientSecret:          optionalString(d, "generic_client_secret"),
+		GenericAuthorizationEndpoint: optionalString(d, "generic_authorization_endpoint"),
+		GenericTokenEndpoint:         optionalString(d, "generic_token_endpoint"),
+		GenericUserinfoEndpoint:      optionalString(d, "generic_userinfo_endpoint"),
+		ProxyBaseURL:                 optionalString(d, "proxy_base_url"),
+		UserEmail:                    optionalString(d, "user_email"),
+		UIAccessMode:                 optionalString(d, "ui_access_mode"),
+	}
+}
+
+// setSSOSettingsResourceData sets Terraform resource data from SSOSettings.
+// Client secrets are left untouched so the values returned by the API never reach state.
+func setSSOSettingsResourceData(d *schema.ResourceData, settings *SSOSettings) error {
+	fields := map[string]interface{}{
+		"google_client_id":               deref(settings.GoogleClientID),
+		"microsoft_client_id":            deref(settings.MicrosoftClientID),
+		"microsoft_tenant":               deref(settings.MicrosoftTenant),
+		"generic_client_id":              deref(settings.GenericClientID),
+		"generic_authorization_endpoint": deref(settings.GenericAuthorizationEndpoint),
+		"generic_token_endpoint":         deref(settings.GenericTokenEndpoint),
+		"generic_userinfo_endpoint":      deref(settings.GenericUserinfoEndpoint),
+		"proxy_base_url":                 deref(settings.ProxyBaseURL),
+		"user_email":                     deref(settings.UserEmail),
+		"ui_access_mode":                 deref(settings.UIAccessMode),
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildDefaultTeamSettings builds DefaultTeamSettings from Terraform resource data
+func buildDefaultTeamSettings(d *schema.ResourceData) *DefaultTeamSettings {
+	return &DefaultTeamSettings{
+		Models:         stringList(d, "models"),
+		MaxBudget:      optionalFloat(d, "max_budget"),
+		BudgetDuration: optionalString(d, "budget_duration"),
+		TPMLimit:       optionalInt(d, "tpm_limit"),
+		RPMLimit:       optionalInt(d, "rpm_limit"),
+	}
+}
+
+// defaultDefaultTeamSettings returns the documented LiteLLM defaults for teams created through SSO.
+// Delete sends these explicitly, as an empty models list is not the same as a missing one.
+func defaultDefaultTeamSettings() *DefaultTeamSettings {
+	return &DefaultTeamSettings{
+		Models: []string{},
+	}
+}
+
+// setDefaultTeamSettingsResourceData sets Terraform resource data from DefaultTeamSettings
+func setDefaultTeamSettingsResourceData(d *schema.ResourceData, settings *DefaultTeamSettings) error {
+	fields := map[string]interface{}{
+		"models":          settings.Models,
+		"max_budget":      deref(settings.MaxBudget),
+		"budget_duration": deref(settings.BudgetDuration),
+		"tpm_limit":       deref(settings.TPMLimit),
+		"rpm_limit":       deref(settings.RPMLimit),
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildInternalUserSettings builds InternalUserSettings from Terraform resource data
+func buildInternalUserSettings(d *schema.ResourceData) *InternalUserSettings {
+	return &InternalUserSettings{
+		UserRole:       optionalString(d, "user_role"),
+		MaxBudget:      optionalFloat(d, "max_budget"),
+		BudgetDuration: optionalString(d, "budget_duration"),
+		Models:         stringList(d, "models"),
+	}
+}
+
+// defaultInternalUserSettings returns the documented LiteLLM defaults for new internal users.
+// Delete sends these explicitly so new users get the internal_user role again.
+func defaultInternalUserSettings() *InternalUserSettings {
+	role := "internal_user"
+	return &InternalUserSettings{
+		UserRole: &role,
+	}
+}
+
+// setInternalUserSettingsResourceData sets Terraform resource data from InternalUserSettings
+func setInternalUserSettingsResourceData(d *schema.ResourceData, settings *InternalUserSettings) error {
+	fields := map[string]interface{}{
+		"user_role":       deref(settings.UserRole),
+		"max_budget":      deref(settings.MaxBudget),
+		"budget_duration": deref(settings.BudgetDuration),
+		"models":          settings.Models,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildUIThemeSettings builds UIThemeSettings from Terraform resource data.
+// logo_url is only taken from the configuration when no logo_file is uploaded.
+func buildUIThemeSettings(d *schema.ResourceData) *UIThemeSettings {
+	settings := &UIThemeSettings{}
+
+	if d.Get("logo_file").(string) == "" {
+		settings.LogoURL = optionalString(d, "logo_url")
+	}
+
+	return settings
+}
+
+// setUIThemeSettingsResourceData sets Terraform resource data from UIThemeSettings
+func setUIThemeSettingsResourceData(d *schema.ResourceData, settings *UIThemeSettings) error {
+	return d.Set("logo_url", deref(settings.LogoURL))
+}
+
+// hashLogoFile returns the hex-encoded SHA-256 hash of a logo file
+func hashLogoFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read logo file %s: %w", path, err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// buildEmailEventSettings builds EmailEventSettings from the event toggles set in the configuration.
+// Events left out of the configuration keep their current value on the server.
+func buildEmailEventSettings(d *schema.ResourceData) *EmailEventSettings {
+	request := &EmailEventSettings{
+		Settings: make([]EmailEventSetting, 0, len(emailEvents)),
+	}
+
+	config := d.GetRawConfig()
+	for field, event := range emailEvents {
+		if !config.IsNull() && config.GetAttr(field).IsNull() {
+			continue
+		}
+		request.Settings = append(request.Settings, EmailEventSetting{
+			Event:   event,
+			Enabled: d.Get(field).(bool),
+		})
+	}
+
+	// Keep the request stable, map iteration order is random
+	sort.Slice(request.Settings, func(i, j int) bool {
+		return request.Settings[i].Event < request.Settings[j].Event
+	})
+
+	return request
+}
+
+// setEmailEventSettingsResourceData sets Terraform resource data from EmailEventSettings.
+// Events unknown to this provider are ignored.
+func setEmailEventSettingsResourceData(d *schema.ResourceData, settings *EmailEventSettings) error {
+	enabled := make(map[string]bool, len(settings.Settings))
+	for _, setting := range settings.Settings {
+		enabled[setting.Event] = setting.Enabled
+	}
+
+	for field, event := range emailEvents {
+		if err := d.Set(field, enabled[event]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// optionalString returns a pointer to the configured string, or nil when it is empty
+func optionalString(d *schema.ResourceData, key string) *string {
+	if v, ok := d.GetOk(key); ok {
+		return utils.StringPtr(v.(string))
+	}
+	return nil
+}
+
+// optionalFloat returns a pointer to the configured float, or nil when it is unset
+func optionalFloat(d *schema.ResourceData, key string) *float64 {
+	if v, ok := d.GetOk(key); ok {
+		return utils.FloatPtr(v.(float64))
+	}
+	return nil
+}
+
+// optionalInt returns a pointer to the configured int, or nil when it is unset
+func optionalInt(d *schema.ResourceData, key string) *int {
+	if v, ok := d.GetOk(key); ok {
+		return utils.IntPtr(v.(int))
+	}
+	return nil
+}
+
+// stringList returns the configured string list, or nil when it is empty
+func stringList(d *schema.ResourceData, key string) []string {
+	list := d.Get(key).([]interface{})
+	if len(list) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// deref returns the value behind p, or the zero value when p is nil.
+// Settings are authoritative, so a value removed on the server must show up as drift.
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,438 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+func TestBuildSSOSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]interface{}
+		expected *SSOSettings
+	}{
+		{
+			name: "microsoft sso",
+			input: map[string]interface{}{
+				"microsoft_client_id":     "client-id",
+				"microsoft_client_secret": "client-secret",
+				"microsoft_tenant":        "tenant-id",
+				"proxy_base_url":          "https://litellm.example.com",
+				"ui_access_mode":          "admin_only",
+			},
+			expected: &SSOSettings{
+				MicrosoftClientID:     stringPtr("client-id"),
+				MicrosoftClientSecret: stringPtr("client-secret"),
+				MicrosoftTenant:       stringPtr("tenant-id"),
+				ProxyBaseURL:          stringPtr("https://litellm.example.com"),
+				UIAccessMode:          stringPtr("admin_only"),
+			},
+		},
+		{
+			name:     "empty settings",
+			input:    map[string]interface{}{},
+			expected: &SSOSettings{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSSOSettingsSchema(), tt.input)
+
+			result := buildSSOSettings(d)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildSSOSettings() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetSSOSettingsResourceDataKeepsSecrets(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSSOSettingsSchema(), map[string]interface{}{
+		"google_client_id":     "old-id",
+		"google_client_secret": "configured-secret",
+	})
+
+	settings := &SSOSettings{
+		GoogleClientID:        stringPtr("new-id"),
+		GoogleClientSecret:    stringPtr("api-secret"),
+		MicrosoftClientSecret: stringPtr("other-secret"),
+	}
+
+	if err := setSSOSettingsResourceData(d, settings); err != nil {
+		t.Fatalf("setSSOSettingsResourceData() unexpected error: %v", err)
+	}
+
+	if d.Get("google_client_id") != "new-id" {
+		t.Errorf("Expected google_client_id to be refreshed, got %v", d.Get("google_client_id"))
+	}
+	if d.Get("google_client_secret") != "configured-secret" {
+		t.Errorf("Expected google_client_secret to keep the configured value, got %v", d.Get("google_client_secret"))
+	}
+	if d.Get("microsoft_client_secret") != "" {
+		t.Errorf("Expected microsoft_client_secret not to be read from the API, got %v", d.Get("microsoft_client_secret"))
+	}
+}
+
+func TestSetDefaultTeamSettingsResourceData(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *DefaultTeamSettings
+		expected map[string]interface{}
+	}{
+		{
+			name: "complete settings",
+			settings: &DefaultTeamSettings{
+				Models:         []string{"gpt-4o"},
+				MaxBudget:      float64Ptr(100.0),
+				BudgetDuration: stringPtr("30d"),
+				TPMLimit:       intPtr(1000),
+				RPMLimit:       intPtr(10),
+			},
+			expected: map[string]interface{}{
+				"max_budget":      100.0,
+				"budget_duration": "30d",
+				"tpm_limit":       1000,
+				"rpm_limit":       10,
+			},
+		},
+		{
+			// Values removed on the server are cleared so that drift shows up in the plan
+			name:     "settings removed on the server",
+			settings: &DefaultTeamSettings{},
+			expected: map[string]interface{}{
+				"max_budget":      0.0,
+				"budget_duration": "",
+				"tpm_limit":       0,
+				"rpm_limit":       0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceDefaultTeamSettingsSchema(), map[string]interface{}{
+				"max_budget":      50.0,
+				"budget_duration": "1d",
+				"tpm_limit":       500,
+				"rpm_limit":       5,
+			})
+
+			if err := setDefaultTeamSettingsResourceData(d, tt.settings); err != nil {
+				t.Fatalf("setDefaultTeamSettingsResourceData() unexpected error: %v", err)
+			}
+
+			for field, want := range tt.expected {
+				if got := d.Get(field); got != want {
+					t.Errorf("Expected %s to be %v, got %v", field, want, got)
+				}
+			}
+			if got := len(d.Get("models").([]interface{})); got != len(tt.settings.Models) {
+				t.Errorf("Expected %d models, got %d", len(tt.settings.Models), got)
+			}
+		})
+	}
+}
+
+func TestBuildInternalUserSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceInternalUserSettingsSchema(), map[string]interface{}{
+		"user_role":       "internal_user_viewer",
+		"max_budget":      10.0,
+		"budget_duration": "7d",
+		"models":          []interface{}{"gpt-4o-mini"},
+	})
+
+	expected := &InternalUserSettings{
+		UserRole:       stringPtr("internal_user_viewer"),
+		MaxBudget:      float64Ptr(10.0),
+		BudgetDuration: stringPtr("7d"),
+		Models:         []string{"gpt-4o-mini"},
+	}
+
+	if result := buildInternalUserSettings(d); !reflect.DeepEqual(result, expected) {
+		t.Errorf("buildInternalUserSettings() = %+v, want %+v", result, expected)
+	}
+}
+
+func TestDeleteSettingsPayloads(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings interface{}
+		expected string
+	}{
+		{
+			name:     "internal user settings",
+			settings: defaultInternalUserSettings(),
+			expected: `{"user_role":"internal_user","max_budget":null,"budget_duration":null,"models":null}`,
+		},
+		{
+			name:     "default team settings",
+			settings: defaultDefaultTeamSettings(),
+			expected: `{"models":[],"max_budget":null,"budget_duration":null,"tpm_limit":null,"rpm_limit":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.settings)
+			if err != nil {
+				t.Fatalf("json.Marshal() unexpected error: %v", err)
+			}
+			if string(body) != tt.expected {
+				t.Errorf("delete payload = %s, want %s", body, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildUIThemeSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]interface{}
+		expected *UIThemeSettings
+	}{
+		{
+			name: "logo url",
+			input: map[string]interface{}{
+				"logo_url": "https://example.com/logo.png",
+			},
+			expected: &UIThemeSettings{
+				LogoURL: stringPtr("https://example.com/logo.png"),
+			},
+		},
+		{
+			// logo_url is set by the upload, so the computed value in state is not sent back
+			name: "uploaded logo file",
+			input: map[string]interface{}{
+				"logo_file": "logo.png",
+				"logo_url":  "/get/image",
+			},
+			expected: &UIThemeSettings{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceUIThemeSettingsSchema(), tt.input)
+
+			if result := buildUIThemeSettings(d); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("buildUIThemeSettings() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetUIThemeSettingsResourceData(t *testing.T) {
+	body := []byte(`{
+		"values": {"logo_url": "https://cdn.example.com/brand/logo.svg"},
+		"field_schema": {
+			"description": "Configuration for UI theme customization",
+			"properties": {"logo_url": {"type": "string", "description": "URL or path to custom logo image"}}
+		}
+	}`)
+
+	var response SettingsResponse[UIThemeSettings]
+	if err := json.Unmarshal(body, &response); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceUIThemeSettingsSchema(), map[string]interface{}{})
+	if err := setUIThemeSettingsResourceData(d, &response.Values); err != nil {
+		t.Fatalf("setUIThemeSettingsResourceData() unexpected error: %v", err)
+	}
+
+	if d.Get("logo_url") != "https://cdn.example.com/brand/logo.svg" {
+		t.Errorf("Expected logo_url to be read back, got %v", d.Get("logo_url"))
+	}
+}
+
+func TestHashLogoFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(path, []byte("logo"), 0o600); err != nil {
+		t.Fatalf("failed to write logo file: %v", err)
+	}
+
+	hash, err := hashLogoFile(path)
+	if err != nil {
+		t.Fatalf("hashLogoFile() unexpected error: %v", err)
+	}
+
+	// sha256("logo")
+	expected := "3598ce6f965b2481fe26316c06b30950c46ac7f8e7229f104aa78f579997668d"
+	if hash != expected {
+		t.Errorf("hashLogoFile() = %s, want %s", hash, expected)
+	}
+
+	if err := os.WriteFile(path, []byte("new logo"), 0o600); err != nil {
+		t.Fatalf("failed to write logo file: %v", err)
+	}
+	if changed, _ := hashLogoFile(path); changed == hash {
+		t.Errorf("Expected the hash to change with the file content")
+	}
+
+	if _, err := hashLogoFile(filepath.Join(t.TempDir(), "missing.png")); err == nil {
+		t.Errorf("Expected an error for a missing logo file")
+	}
+}
+
+func TestBuildEmailEventSettings(t *testing.T) {
+	// Test resource data has no raw configuration, so every toggle is sent with its current value
+	d := schema.TestResourceDataRaw(t, resourceEmailEventSettingsSchema(), map[string]interface{}{
+		"new_user_invitation": false,
+		"virtual_key_created": true,
+	})
+
+	request := buildEmailEventSettings(d)
+
+	if len(request.Settings) != len(emailEvents) {
+		t.Fatalf("Expected %d settings, got %d", len(emailEvents), len(request.Settings))
+	}
+
+	for i, setting := range request.Settings {
+		if i > 0 && request.Settings[i-1].Event > setting.Event {
+			t.Errorf("Expected settings sorted by event, got %v", request.Settings)
+		}
+		if expected := setting.Event == "Virtual Key Created"; setting.Enabled != expected {
+			t.Errorf("Expected '%s' enabled to be %v, got %v", setting.Event, expected, setting.Enabled)
+		}
+	}
+}
+
+func TestSetEmailEventSettingsResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceEmailEventSettingsSchema(), map[string]interface{}{})
+
+	settings := &EmailEventSettings{
+		Settings: []EmailEventSetting{
+			{Event: "Virtual Key Created", Enabled: true},
+			{Event: "New User Invitation", Enabled: false},
+			{Event: "Some Future Event", Enabled: true},
+		},
+	}
+
+	if err := setEmailEventSettingsResourceData(d, settings); err != nil {
+		t.Fatalf("setEmailEventSettingsResourceData() unexpected error: %v", err)
+	}
+
+	if d.Get("virtual_key_created") != true {
+		t.Errorf("Expected virtual_key_created to be true, got %v", d.Get("virtual_key_created"))
+	}
+	if d.Get("new_user_invitation") != false {
+		t.Errorf("Expected new_user_invitation to be false, got %v", d.Get("new_user_invitation"))
+	}
+}
+
+func TestEmailEventSettingsSchema(t *testing.T) {
+	// Only the events of the EmailEvent enum in the LiteLLM API are supported
+	expected := []string{"new_user_invitation", "virtual_key_created"}
+
+	fields := make([]string, 0, len(expected))
+	for field := range resourceEmailEventSettingsSchema() {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("Expected email event fields %v, got %v", expected, fields)
+	}
+}
+
+func TestHasAllowedIP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/get/allowed_ips" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": ["203.0.113.10", "10.0.0.0/8"]}`))
+	}))
+	defer server.Close()
+
+	client := litellm.NewClient(server.URL, "sk-test", false)
+
+	tests := []struct {
+		ip       string
+		expected bool
+	}{
+		{ip: "203.0.113.10", expected: true},
+		{ip: "10.0.0.0/8", expected: true},
+		{ip: "198.51.100.7", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			found, err := hasAllowedIP(context.Background(), client, tt.ip)
+			if err != nil {
+				t.Fatalf("hasAllowedIP() unexpected error: %v", err)
+			}
+			if found != tt.expected {
+				t.Errorf("hasAllowedIP(%q) = %t, want %t", tt.ip, found, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHasAllowedIPResponses(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectError bool
+	}{
+		{
+			name:        "allowlist not configured",
+			body:        `{"data": null}`,
+			expectError: false,
+		},
+		{
+			name:        "data field missing",
+			body:        `{}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := litellm.NewClient(server.URL, "sk-test", false)
+
+			found, err := hasAllowedIP(context.Background(), client, "203.0.113.10")
+			if tt.expectError && err == nil {
+				t.Errorf("Expected an error for response %s", tt.body)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("hasAllowedIP() unexpected error: %v", err)
+			}
+			if found {
+				t.Errorf("Expected the IP not to be found in response %s", tt.body)
+			}
+		})
+	}
+}
+
+// Helper functions for creating pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
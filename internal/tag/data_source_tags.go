@@ -0,0 +1,98 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+func DataSourceLiteLLMTags() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLiteLLMTagsRead,
+		Schema:      dataSourceTagsSchema(),
+	}
+}
+
+func dataSourceLiteLLMTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*litellm.Client)
+
+	tags, err := listTags(ctx, c)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read tags: %w", err))
+	}
+
+	selected, err := selectTagsByName(tags, expandStringList(d.Get("names").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tagNames := make([]string, 0, len(selected))
+	for _, tag := range selected {
+		tagNames = append(tagNames, tag.Name)
+	}
+
+	d.SetId("tags")
+
+	if err := d.Set("tag_names", tagNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tags", flattenTagList(selected)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// selectTagsByName returns the tags matching names, in the requested order.
+// All tags are returned when names is empty; a missing name is an error.
+func selectTagsByName(tags []TagResponse, names []string) ([]TagResponse, error) {
+	if len(names) == 0 {
+		return tags, nil
+	}
+
+	byName := make(map[string]TagResponse, len(tags))
+	for _, tag := range tags {
+		byName[tag.Name] = tag
+	}
+
+	selected := make([]TagResponse, 0, len(names))
+	var missing []string
+	for _, name := range names {
+		tag, ok := byName[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		selected = append(selected, tag)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("tags not found: %s", strings.Join(missing, ", "))
+	}
+
+	return selected, nil
+}
+
+// flattenTagList converts tags into the data source's tags list
+func flattenTagList(tags []TagResponse) []interface{} {
+	result := make([]interface{}, 0, len(tags))
+	for _, tag := range tags {
+		budgetID := tag.BudgetID
+		if budgetID == "" && tag.LitellmBudgetTable != nil {
+			budgetID = tag.LitellmBudgetTable.BudgetID
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":        tag.Name,
+			"description": tag.Description,
+			"models":      tag.Models,
+			"budget_id":   budgetID,
+			"spend":       tag.Spend,
+		})
+	}
+	return result
+}
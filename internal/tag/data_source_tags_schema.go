@@ -0,0 +1,57 @@
+package tag
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTagsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"names": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Tag names to look up. Reading the data source fails if any of them does not exist. If not set, all tags are returned.",
+		},
+		"tag_names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Names of the returned tags",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Tags defined in LiteLLM",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the tag",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Description of the tag",
+					},
+					"models": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Model IDs that requests with the tag are routed to",
+					},
+					"budget_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the budget attached to the tag",
+					},
+					"spend": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "Current spend for requests with the tag",
+					},
+				},
+			},
+		},
+	}
+}
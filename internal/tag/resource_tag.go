@@ -0,0 +1,95 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceTag defines the schema for the LiteLLM tag resource.
+func ResourceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTagCreate,
+		ReadContext:   resourceTagRead,
+		UpdateContext: resourceTagUpdate,
+		DeleteContext: resourceTagDelete,
+		Importer:      TagImporter(),
+		Schema:        resourceTagSchema(),
+	}
+}
+
+// resourceTagCreate creates a new tag in LiteLLM.
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Creating LiteLLM tag", map[string]interface{}{"name": d.Get("name")})
+
+	client := m.(*litellm.Client)
+
+	request := buildTagRequest(d)
+
+	if err := createTag(ctx, client, request); err != nil {
+		return diag.Errorf("error creating tag: %v", err)
+	}
+
+	d.SetId(request.Name)
+	tflog.Info(ctx, "Created tag", map[string]interface{}{"name": request.Name})
+
+	return resourceTagRead(ctx, d, m)
+}
+
+// resourceTagRead reads the current state of a tag from LiteLLM.
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM tag", map[string]interface{}{"name": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	tagResp, err := getTag(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("error reading tag: %v", err)
+	}
+
+	if tagResp == nil {
+		tflog.Warn(ctx, "Tag not found, removing from state", map[string]interface{}{"name": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := setTagResourceData(d, tagResp); err != nil {
+		return diag.Errorf("error setting tag data: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully read tag", map[string]interface{}{"name": d.Id()})
+	return nil
+}
+
+// resourceTagUpdate updates an existing tag in LiteLLM.
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM tag", map[string]interface{}{"name": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	request := buildTagRequest(d)
+
+	if err := updateTag(ctx, client, request); err != nil {
+		return diag.Errorf("error updating tag: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully updated tag", map[string]interface{}{"name": d.Id()})
+	return resourceTagRead(ctx, d, m)
+}
+
+// resourceTagDelete deletes a tag from LiteLLM.
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM tag", map[string]interface{}{"name": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	if err := deleteTag(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("error deleting tag: %v", err)
+	}
+
+	tflog.Info(ctx, "Successfully deleted tag", map[string]interface{}{"name": d.Id()})
+	return nil
+}
@@ -0,0 +1,131 @@
+package tag
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceTagSchema returns the schema for the tag resource
+func resourceTagSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the tag, as used in the tags list of keys and teams and in request metadata",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of the tag",
+		},
+		"models": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "List of model IDs that requests with this tag are routed to",
+		},
+		"max_budget": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Maximum budget for requests with this tag",
+		},
+		"soft_budget": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Soft budget limit that triggers alerts but doesn't block requests",
+		},
+		"max_parallel_requests": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximum number of parallel requests for the tag",
+		},
+		"tpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Tokens per minute limit for the tag",
+		},
+		"rpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Requests per minute limit for the tag",
+		},
+		"budget_duration": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(\d+[smhd])$`),
+				"Budget duration must be in format: number followed by 's' (seconds), 'm' (minutes), 'h' (hours), or 'd' (days). Examples: '30s', '30m', '30h', '30d'",
+			),
+			Description: "Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ('30s'), minutes ('30m'), hours ('30h'), days ('30d').",
+		},
+		"model_max_budget": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Model-specific budgets for the tag",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"model": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the model the budget applies to",
+					},
+					"max_budget": {
+						Type:        schema.TypeFloat,
+						Optional:    true,
+						Description: "Maximum budget for the model",
+					},
+					"budget_duration": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Duration after which the model budget is reset",
+					},
+					"tpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Tokens per minute limit for the model",
+					},
+					"rpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Requests per minute limit for the model",
+					},
+				},
+			},
+		},
+		// Computed fields
+		"model_info": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Map of model IDs to model names for the tag's models",
+		},
+		"budget_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the budget LiteLLM created for the tag",
+		},
+		"spend": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Current spend for requests with this tag",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "User who created the tag",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the tag was created",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the tag was last updated",
+		},
+	}
+}
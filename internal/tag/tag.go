@@ -0,0 +1,39 @@
+package tag
+
+import (
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// TagRequest represents the request body for /tag/new and /tag/update.
+// The budget limits are stored on the tag's budget.
+type TagRequest struct {
+	Name        string                 `json:"name"`
+	Description utils.Nullable[string] `json:"description,omitzero"` // Null clears the description
+	Models      *[]string              `json:"models,omitempty"`     // An empty list clears the models
+	budget.BudgetLimits
+}
+
+// TagInfoRequest represents the request body for /tag/info
+type TagInfoRequest struct {
+	Names []string `json:"names"`
+}
+
+// TagDeleteRequest represents the request body for /tag/delete
+type TagDeleteRequest struct {
+	Name string `json:"name"`
+}
+
+// TagResponse represents a tag as returned by the API
+type TagResponse struct {
+	Name               string                     `json:"name"`
+	Description        string                     `json:"description"`
+	Models             []string                   `json:"models"`
+	ModelInfo          map[string]string          `json:"model_info"`
+	BudgetID           string                     `json:"budget_id"`
+	Spend              float64                    `json:"spend"`
+	LitellmBudgetTable *budget.LitellmBudgetTable `json:"litellm_budget_table"`
+	CreatedBy          string                     `json:"created_by"`
+	CreatedAt          string                     `json:"created_at"`
+	UpdatedAt          string                     `json:"updated_at"`
+}
@@ -0,0 +1,94 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// createTag creates a new tag using the typed request/response pattern
+func createTag(ctx context.Context, c *litellm.Client, request *TagRequest) error {
+	_, err := litellm.SendRequestTyped[TagRequest, interface{}](
+		ctx, c, http.MethodPost, "/tag/new", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return nil
+}
+
+// getTag retrieves a tag by name.
+// It returns nil without an error when the tag does not exist.
+func getTag(ctx context.Context, c *litellm.Client, name string) (*TagResponse, error) {
+	infoRequest := &TagInfoRequest{
+		Names: []string{name},
+	}
+
+	response, err := litellm.SendRequestTyped[TagInfoRequest, map[string]TagResponse](
+		ctx, c, http.MethodPost, "/tag/info", infoRequest,
+	)
+	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	tag, ok := (*response)[name]
+	if !ok {
+		return nil, nil
+	}
+	if tag.Name == "" {
+		tag.Name = name
+	}
+
+	return &tag, nil
+}
+
+// listTags retrieves all tags
+func listTags(ctx context.Context, c *litellm.Client) ([]TagResponse, error) {
+	response, err := litellm.SendRequestTyped[interface{}, []TagResponse](
+		ctx, c, http.MethodGet, "/tag/list", nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return *response, nil
+}
+
+// updateTag updates an existing tag using the typed request pattern
+func updateTag(ctx context.Context, c *litellm.Client, request *TagRequest) error {
+	_, err := litellm.SendRequestTyped[TagRequest, interface{}](
+		ctx, c, http.MethodPost, "/tag/update", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return nil
+}
+
+// deleteTag deletes a tag by name
+func deleteTag(ctx context.Context, c *litellm.Client, name string) error {
+	deleteRequest := &TagDeleteRequest{
+		Name: name,
+	}
+
+	_, err := litellm.SendRequestTyped[TagDeleteRequest, interface{}](
+		ctx, c, http.MethodPost, "/tag/delete", deleteRequest,
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}
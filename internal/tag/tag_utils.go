@@ -0,0 +1,85 @@
+package tag
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// buildTagRequest builds a TagRequest from Terraform resource data.
+// The same payload is used for /tag/new and /tag/update.
+func buildTagRequest(d *schema.ResourceData) *TagRequest {
+	request := &TagRequest{
+		Name: d.Get("name").(string),
+	}
+
+	// String fields, a removed description is cleared on update
+	request.Description = utils.GetNullable[string](d, "description")
+
+	// String list fields, removed models are cleared on update
+	if v, ok := d.GetOk("models"); ok || d.HasChange("models") {
+		models := expandStringList(v.([]interface{}))
+		request.Models = &models
+	}
+
+	// Budget limits, removed limits are cleared on update
+	request.BudgetLimits = budget.ExpandBudgetLimits(d)
+
+	return request
+}
+
+// setTagResourceData sets Terraform resource data from a TagResponse
+func setTagResourceData(d *schema.ResourceData, tag *TagResponse) error {
+	budgetID := tag.BudgetID
+	if budgetID == "" && tag.LitellmBudgetTable != nil {
+		budgetID = tag.LitellmBudgetTable.BudgetID
+	}
+
+	fields := map[string]interface{}{
+		"name":        tag.Name,
+		"description": tag.Description,
+		"budget_id":   budgetID,
+		"created_by":  tag.CreatedBy,
+		"created_at":  tag.CreatedAt,
+		"updated_at":  tag.UpdatedAt,
+	}
+
+	for field, value := range fields {
+		// Use SetIfNotZero to preserve existing values when API doesn't return them
+		utils.SetIfNotZero(d, field, value)
+	}
+
+	// Always set computed spend, including zero
+	if err := d.Set("spend", tag.Spend); err != nil {
+		return err
+	}
+
+	if tag.Models != nil {
+		if err := d.Set("models", tag.Models); err != nil {
+			return err
+		}
+	}
+	if tag.ModelInfo != nil {
+		if err := d.Set("model_info", tag.ModelInfo); err != nil {
+			return err
+		}
+	}
+
+	// Budget limits are returned through the attached budget table
+	if err := budget.SetBudgetLimits(d, tag.LitellmBudgetTable); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// expandStringList converts []interface{} to []string
+func expandStringList(list []interface{}) []string {
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+package tag
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/budget"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+	"github.com/scalepad/terraform-provider-litellm/internal/utils/testutil"
+)
+
+func TestBuildTagRequest(t *testing.T) {
+	resource := ResourceTag()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name":            "premium",
+		"description":     "Premium traffic",
+		"models":          []interface{}{"model-id-1", "model-id-2"},
+		"max_budget":      250.0,
+		"budget_duration": "30d",
+		"rpm_limit":       100,
+	})
+
+	result := buildTagRequest(d)
+
+	expected := &TagRequest{
+		Name:        "premium",
+		Description: utils.NullableValue("Premium traffic"),
+		Models:      &[]string{"model-id-1", "model-id-2"},
+		BudgetLimits: budget.BudgetLimits{
+			MaxBudget:      utils.NullableValue(250.0),
+			BudgetDuration: utils.NullableValue("30d"),
+			RPMLimit:       utils.NullableValue(100),
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("buildTagRequest() = %+v, want %+v", result, expected)
+	}
+}
+
+func TestBuildTagRequestClearsRemovedLimits(t *testing.T) {
+	state := map[string]interface{}{
+		"name":            "premium",
+		"max_budget":      250.0,
+		"budget_duration": "30d",
+		"rpm_limit":       100,
+	}
+	config := map[string]interface{}{
+		"name":      "premium",
+		"rpm_limit": 200,
+	}
+
+	d := testutil.ResourceDataWithState(t, ResourceTag(), state, config)
+	result := buildTagRequest(d)
+
+	expected := &TagRequest{
+		Name: "premium",
+		BudgetLimits: budget.BudgetLimits{
+			MaxBudget:      utils.NullValue[float64](),
+			BudgetDuration: utils.NullValue[string](),
+			RPMLimit:       utils.NullableValue(200),
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("buildTagRequest() = %+v, want %+v", result, expected)
+	}
+}
+
+func TestBuildTagRequestClearsRemovedDescriptionAndModels(t *testing.T) {
+	state := map[string]interface{}{
+		"name":        "premium",
+		"description": "Premium traffic",
+		"models":      []interface{}{"model-id-1"},
+	}
+	config := map[string]interface{}{
+		"name": "premium",
+	}
+
+	d := testutil.ResourceDataWithState(t, ResourceTag(), state, config)
+
+	body, err := json.Marshal(buildTagRequest(d))
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	expectedBody := `{"name":"premium","description":null,"models":[]}`
+	if string(body) != expectedBody {
+		t.Errorf("request body = %s, want %s", body, expectedBody)
+	}
+}
+
+func TestSetTagResourceData(t *testing.T) {
+	resource := ResourceTag()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+
+	tagResp := &TagResponse{
+		Name:        "premium",
+		Description: "Premium traffic",
+		Models:      []string{"model-id-1"},
+		ModelInfo:   map[string]string{"model-id-1": "gpt-4o"},
+		Spend:       3.5,
+		LitellmBudgetTable: &budget.LitellmBudgetTable{
+			BudgetID:  "budget-1",
+			MaxBudget: float64Ptr(250.0),
+		},
+	}
+
+	if err := setTagResourceData(d, tagResp); err != nil {
+		t.Fatalf("setTagResourceData() unexpected error: %v", err)
+	}
+
+	if d.Get("budget_id") != "budget-1" {
+		t.Errorf("Expected budget_id from budget table, got %v", d.Get("budget_id"))
+	}
+	if d.Get("max_budget") != 250.0 {
+		t.Errorf("Expected max_budget 250, got %v", d.Get("max_budget"))
+	}
+	if d.Get("spend") != 3.5 {
+		t.Errorf("Expected spend 3.5, got %v", d.Get("spend"))
+	}
+	if d.Get("model_info.model-id-1") != "gpt-4o" {
+		t.Errorf("Expected model_info entry for model-id-1, got %v", d.Get("model_info"))
+	}
+}
+
+func TestSelectTagsByName(t *testing.T) {
+	tags := []TagResponse{
+		{Name: "premium"},
+		{Name: "batch"},
+	}
+
+	selected, err := selectTagsByName(tags, []string{"batch"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selected) != 1 || selected[0].Name != "batch" {
+		t.Errorf("Unexpected selection: %+v", selected)
+	}
+
+	if _, err := selectTagsByName(tags, []string{"premium", "free"}); err == nil {
+		t.Error("Expected an error for a missing tag")
+	}
+}
+
+// Helper functions for creating pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
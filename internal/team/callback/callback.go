@@ -0,0 +1,42 @@
+package callback
+
+// Callback types accepted by the /team/{team_id}/callback endpoint
+const (
+	callbackTypeSuccess           = "success"
+	callbackTypeFailure           = "failure"
+	callbackTypeSuccessAndFailure = "success_and_failure"
+)
+
+// TeamCallbackRequest represents a request to add a logging callback to a team
+type TeamCallbackRequest struct {
+	CallbackName string            `json:"callback_name"`
+	CallbackType string            `json:"callback_type"`
+	CallbackVars map[string]string `json:"callback_vars"`
+}
+
+// TeamCallbackResponse represents the response of GET /team/{team_id}/callback
+type TeamCallbackResponse struct {
+	Status string           `json:"status"`
+	Data   TeamCallbackData `json:"data"`
+}
+
+// TeamCallbackData represents the logging callbacks configured on a team
+type TeamCallbackData struct {
+	TeamID           string            `json:"team_id"`
+	SuccessCallbacks []string          `json:"success_callbacks"`
+	FailureCallbacks []string          `json:"failure_callbacks"`
+	CallbackVars     map[string]string `json:"callback_vars"`
+}
+
+// TeamCallbackSettings represents the callback_settings entry LiteLLM stores in team metadata
+type TeamCallbackSettings struct {
+	SuccessCallback []string          `json:"success_callback"`
+	FailureCallback []string          `json:"failure_callback"`
+	CallbackVars    map[string]string `json:"callback_vars"`
+}
+
+// teamMetadataUpdateRequest represents a /team/update request that only replaces team metadata
+type teamMetadataUpdateRequest struct {
+	TeamID   string                 `json:"team_id"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
@@ -0,0 +1,134 @@
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+	"github.com/scalepad/terraform-provider-litellm/internal/team"
+)
+
+// addTeamCallback adds a logging callback to a team
+func addTeamCallback(ctx context.Context, c *litellm.Client, teamID string, request *TeamCallbackRequest) error {
+	_, err := litellm.SendRequestTyped[TeamCallbackRequest, interface{}](
+		ctx, c, http.MethodPost, fmt.Sprintf("/team/%s/callback", url.PathEscape(teamID)), request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add team callback: %w", err)
+	}
+
+	return nil
+}
+
+// getTeamCallbacks retrieves the logging callbacks configured on a team
+func getTeamCallbacks(ctx context.Context, c *litellm.Client, teamID string) (*TeamCallbackData, error) {
+	response, err := litellm.SendRequestTyped[interface{}, TeamCallbackResponse](
+		ctx, c, http.MethodGet, fmt.Sprintf("/team/%s/callback", url.PathEscape(teamID)), nil,
+	)
+	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get team callbacks: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// disableTeamLogging turns off all logging callbacks for a team
+func disableTeamLogging(ctx context.Context, c *litellm.Client, teamID string) error {
+	_, err := litellm.SendRequestTyped[interface{}, interface{}](
+		ctx, c, http.MethodPost, fmt.Sprintf("/team/%s/disable_logging", url.PathEscape(teamID)), nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to disable team logging: %w", err)
+	}
+
+	return nil
+}
+
+// getTeamCallbackSettings retrieves the callback_settings entry of the team metadata.
+// It returns nil settings when the team does not exist, and present=false when the team has no callback_settings.
+func getTeamCallbackSettings(ctx context.Context, c *litellm.Client, teamID string) (*TeamCallbackSettings, bool, error) {
+	teamResp, err := team.GetTeam(ctx, c, teamID)
+	if err != nil {
+		return nil, false, err
+	}
+	if teamResp == nil {
+		return nil, false, nil
+	}
+
+	raw, present := teamResp.TeamInfo.Metadata[team.CallbackSettingsKey]
+	settings, err := decodeCallbackSettings(raw)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode team callback settings: %w", err)
+	}
+
+	return settings, present, nil
+}
+
+// updateTeamCallbackSettings rewrites the callback_settings entry of the team metadata.
+// LiteLLM has no endpoint to change or remove a callback, so the settings are edited in place.
+// When update returns nil, callback_settings is removed and the team falls back to the proxy defaults.
+func updateTeamCallbackSettings(ctx context.Context, c *litellm.Client, teamID string, update func(*TeamCallbackSettings) *TeamCallbackSettings) error {
+	teamResp, err := team.GetTeam(ctx, c, teamID)
+	if err != nil {
+		return err
+	}
+	if teamResp == nil {
+		// Nothing to update on a team that no longer exists
+		return nil
+	}
+
+	metadata := make(map[string]interface{}, len(teamResp.TeamInfo.Metadata)+1)
+	for k, v := range teamResp.TeamInfo.Metadata {
+		metadata[k] = v
+	}
+
+	settings, err := decodeCallbackSettings(metadata[team.CallbackSettingsKey])
+	if err != nil {
+		return fmt.Errorf("failed to decode team callback settings: %w", err)
+	}
+
+	if updated := update(settings); updated != nil {
+		metadata[team.CallbackSettingsKey] = updated
+	} else {
+		delete(metadata, team.CallbackSettingsKey)
+	}
+
+	request := &teamMetadataUpdateRequest{
+		TeamID:   teamID,
+		Metadata: metadata,
+	}
+
+	_, err = litellm.SendRequestTyped[teamMetadataUpdateRequest, interface{}](
+		ctx, c, http.MethodPost, "/team/update", request,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update team callback settings: %w", err)
+	}
+
+	return nil
+}
+
+// decodeCallbackSettings converts the raw callback_settings metadata value into TeamCallbackSettings
+func decodeCallbackSettings(raw interface{}) (*TeamCallbackSettings, error) {
+	settings := &TeamCallbackSettings{}
+	if raw == nil {
+		return settings, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
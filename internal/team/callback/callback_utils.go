@@ -0,0 +1,107 @@
+package callback
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// buildTeamCallbackRequest builds a TeamCallbackRequest from Terraform resource data
+func buildTeamCallbackRequest(d *schema.ResourceData) *TeamCallbackRequest {
+	return &TeamCallbackRequest{
+		CallbackName: d.Get("callback_name").(string),
+		CallbackType: d.Get("callback_type").(string),
+		CallbackVars: expandCallbackVars(d.Get("callback_vars").(map[string]interface{})),
+	}
+}
+
+// setTeamCallbackResourceData sets Terraform resource data from the team callbacks.
+// It returns false when the callback is no longer configured on the team.
+func setTeamCallbackResourceData(d *schema.ResourceData, data *TeamCallbackData) (bool, error) {
+	callbackType := callbackTypeOf(data, d.Get("callback_name").(string))
+	if callbackType == "" {
+		return false, nil
+	}
+
+	if err := d.Set("callback_type", callbackType); err != nil {
+		return true, err
+	}
+
+	// callback_vars are shared by every callback of the team, so only the keys
+	// managed by this resource are refreshed. Keys removed on the server are dropped
+	// from state so the next apply writes them again.
+	vars := make(map[string]interface{})
+	for key := range d.Get("callback_vars").(map[string]interface{}) {
+		if value, ok := data.CallbackVars[key]; ok {
+			vars[key] = value
+		}
+	}
+
+	return true, d.Set("callback_vars", vars)
+}
+
+// loggingDisabled reports whether team logging was turned off through /team/{team_id}/disable_logging.
+// A disabled team carries callback_settings with no callbacks, while a team without
+// callback_settings uses the proxy-wide callbacks.
+func loggingDisabled(settings *TeamCallbackSettings, present bool) bool {
+	return present && len(settings.SuccessCallback) == 0 && len(settings.FailureCallback) == 0
+}
+
+// callbackTypeOf returns the callback type of the named callback, or an empty string when it is not configured
+func callbackTypeOf(data *TeamCallbackData, callbackName string) string {
+	success := slices.Contains(data.SuccessCallbacks, callbackName)
+	failure := slices.Contains(data.FailureCallbacks, callbackName)
+
+	switch {
+	case success && failure:
+		return callbackTypeSuccessAndFailure
+	case success:
+		return callbackTypeSuccess
+	case failure:
+		return callbackTypeFailure
+	default:
+		return ""
+	}
+}
+
+// removeCallback removes the named callback and its variables from the team callback settings
+func removeCallback(settings *TeamCallbackSettings, callbackName string, vars map[string]string) *TeamCallbackSettings {
+	settings.SuccessCallback = slices.DeleteFunc(settings.SuccessCallback, func(name string) bool { return name == callbackName })
+	settings.FailureCallback = slices.DeleteFunc(settings.FailureCallback, func(name string) bool { return name == callbackName })
+	for key := range vars {
+		delete(settings.CallbackVars, key)
+	}
+	return settings
+}
+
+// applyCallback replaces the named callback in the team callback settings with the given request
+func applyCallback(settings *TeamCallbackSettings, request *TeamCallbackRequest, oldVars map[string]string) *TeamCallbackSettings {
+	settings = removeCallback(settings, request.CallbackName, oldVars)
+
+	if request.CallbackType == callbackTypeSuccess || request.CallbackType == callbackTypeSuccessAndFailure {
+		settings.SuccessCallback = append(settings.SuccessCallback, request.CallbackName)
+	}
+	if request.CallbackType == callbackTypeFailure || request.CallbackType == callbackTypeSuccessAndFailure {
+		settings.FailureCallback = append(settings.FailureCallback, request.CallbackName)
+	}
+
+	if len(request.CallbackVars) > 0 && settings.CallbackVars == nil {
+		settings.CallbackVars = make(map[string]string, len(request.CallbackVars))
+	}
+	for key, value := range request.CallbackVars {
+		settings.CallbackVars[key] = value
+	}
+
+	return settings
+}
+
+// expandCallbackVars converts the callback_vars map into the API representation
+func expandCallbackVars(m map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(m))
+	for key, value := range m {
+		if s, ok := value.(string); ok {
+			result[key] = s
+		}
+	}
+	return result
+}
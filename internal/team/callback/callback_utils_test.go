@@ -0,0 +1,199 @@
+package callback
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBuildTeamCallbackRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceTeamCallbackSchema(), map[string]interface{}{
+		"team_id":       "team-1",
+		"callback_name": "langfuse",
+		"callback_type": "success",
+		"callback_vars": map[string]interface{}{
+			"langfuse_public_key": "pk",
+			"langfuse_secret_key": "sk",
+		},
+	})
+
+	expected := &TeamCallbackRequest{
+		CallbackName: "langfuse",
+		CallbackType: "success",
+		CallbackVars: map[string]string{
+			"langfuse_public_key": "pk",
+			"langfuse_secret_key": "sk",
+		},
+	}
+
+	if result := buildTeamCallbackRequest(d); !reflect.DeepEqual(result, expected) {
+		t.Errorf("buildTeamCallbackRequest() = %+v, want %+v", result, expected)
+	}
+}
+
+func TestSetTeamCallbackResourceData(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         *TeamCallbackData
+		expectFound  bool
+		expectedType string
+		expectedVars map[string]interface{}
+	}{
+		{
+			name: "success and failure callback",
+			data: &TeamCallbackData{
+				SuccessCallbacks: []string{"langfuse"},
+				FailureCallbacks: []string{"langfuse"},
+				CallbackVars: map[string]string{
+					"langfuse_public_key": "pk-new",
+					"langfuse_secret_key": "sk",
+					"datadog_api_key":     "other",
+				},
+			},
+			expectFound:  true,
+			expectedType: "success_and_failure",
+			expectedVars: map[string]interface{}{
+				"langfuse_public_key": "pk-new",
+				"langfuse_secret_key": "sk",
+			},
+		},
+		{
+			name: "failure only callback with a removed variable",
+			data: &TeamCallbackData{
+				FailureCallbacks: []string{"langfuse"},
+				CallbackVars:     map[string]string{"langfuse_public_key": "pk"},
+			},
+			expectFound:  true,
+			expectedType: "failure",
+			expectedVars: map[string]interface{}{
+				"langfuse_public_key": "pk",
+			},
+		},
+		{
+			name: "callback removed on the server",
+			data: &TeamCallbackData{
+				SuccessCallbacks: []string{"datadog"},
+			},
+			expectFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceTeamCallbackSchema(), map[string]interface{}{
+				"team_id":       "team-1",
+				"callback_name": "langfuse",
+				"callback_vars": map[string]interface{}{
+					"langfuse_public_key": "pk",
+					"langfuse_secret_key": "sk",
+				},
+			})
+
+			found, err := setTeamCallbackResourceData(d, tt.data)
+			if err != nil {
+				t.Fatalf("setTeamCallbackResourceData() unexpected error: %v", err)
+			}
+			if found != tt.expectFound {
+				t.Fatalf("Expected found %v, got %v", tt.expectFound, found)
+			}
+			if !found {
+				return
+			}
+
+			if d.Get("callback_type") != tt.expectedType {
+				t.Errorf("Expected callback_type %s, got %v", tt.expectedType, d.Get("callback_type"))
+			}
+			if vars := d.Get("callback_vars").(map[string]interface{}); !reflect.DeepEqual(vars, tt.expectedVars) {
+				t.Errorf("Expected callback_vars %v, got %v", tt.expectedVars, vars)
+			}
+		})
+	}
+}
+
+func TestApplyCallback(t *testing.T) {
+	settings := &TeamCallbackSettings{
+		SuccessCallback: []string{"datadog", "langfuse"},
+		FailureCallback: []string{"langfuse"},
+		CallbackVars: map[string]string{
+			"datadog_api_key":      "dd",
+			"langfuse_public_key":  "pk",
+			"langfuse_old_setting": "old",
+		},
+	}
+
+	request := &TeamCallbackRequest{
+		CallbackName: "langfuse",
+		CallbackType: "failure",
+		CallbackVars: map[string]string{"langfuse_public_key": "pk-new"},
+	}
+	oldVars := map[string]string{"langfuse_public_key": "pk", "langfuse_old_setting": "old"}
+
+	expected := &TeamCallbackSettings{
+		SuccessCallback: []string{"datadog"},
+		FailureCallback: []string{"langfuse"},
+		CallbackVars: map[string]string{
+			"datadog_api_key":     "dd",
+			"langfuse_public_key": "pk-new",
+		},
+	}
+
+	if result := applyCallback(settings, request, oldVars); !reflect.DeepEqual(result, expected) {
+		t.Errorf("applyCallback() = %+v, want %+v", result, expected)
+	}
+}
+
+func TestRemoveCallback(t *testing.T) {
+	settings := &TeamCallbackSettings{
+		SuccessCallback: []string{"langfuse", "datadog"},
+		FailureCallback: []string{"langfuse"},
+		CallbackVars:    map[string]string{"datadog_api_key": "dd", "langfuse_public_key": "pk"},
+	}
+
+	expected := &TeamCallbackSettings{
+		SuccessCallback: []string{"datadog"},
+		FailureCallback: []string{},
+		CallbackVars:    map[string]string{"datadog_api_key": "dd"},
+	}
+
+	result := removeCallback(settings, "langfuse", map[string]string{"langfuse_public_key": "pk"})
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("removeCallback() = %+v, want %+v", result, expected)
+	}
+}
+
+func TestLoggingDisabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *TeamCallbackSettings
+		present  bool
+		expected bool
+	}{
+		{
+			name:     "no callback settings",
+			settings: &TeamCallbackSettings{},
+			present:  false,
+			expected: false,
+		},
+		{
+			name:     "callbacks cleared",
+			settings: &TeamCallbackSettings{SuccessCallback: []string{}, FailureCallback: []string{}},
+			present:  true,
+			expected: true,
+		},
+		{
+			name:     "callback configured",
+			settings: &TeamCallbackSettings{SuccessCallback: []string{"langfuse"}},
+			present:  true,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := loggingDisabled(tt.settings, tt.present); result != tt.expected {
+				t.Errorf("loggingDisabled() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package callback
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TeamCallbackImporter provides import functionality for LiteLLM team callback resources
+func TeamCallbackImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: teamCallbackImportState,
+	}
+}
+
+func teamCallbackImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Expected format: "team_id:callback_name", or "team_id" for a team with logging disabled
+	importID := d.Id()
+
+	teamID, callbackName, hasCallback := strings.Cut(importID, ":")
+	if teamID == "" || (hasCallback && callbackName == "") {
+		return nil, fmt.Errorf("invalid import ID format. Expected 'team_id:callback_name' or 'team_id', got: %s", importID)
+	}
+
+	d.Set("team_id", teamID)
+	if hasCallback {
+		d.Set("callback_name", callbackName)
+		d.Set("callback_type", callbackTypeSuccessAndFailure)
+	} else {
+		d.Set("disable_logging", true)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,146 @@
+package callback
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceTeamCallback defines the schema for the LiteLLM team callback resource.
+func ResourceTeamCallback() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamCallbackCreate,
+		ReadContext:   resourceTeamCallbackRead,
+		UpdateContext: resourceTeamCallbackUpdate,
+		DeleteContext: resourceTeamCallbackDelete,
+		Importer:      TeamCallbackImporter(),
+		Schema:        resourceTeamCallbackSchema(),
+	}
+}
+
+// resourceTeamCallbackCreate adds a logging callback to a team, or disables team logging.
+func resourceTeamCallbackCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellm.Client)
+	teamID := d.Get("team_id").(string)
+
+	if d.Get("disable_logging").(bool) {
+		tflog.Info(ctx, "Disabling LiteLLM team logging", map[string]interface{}{"team_id": teamID})
+
+		if err := disableTeamLogging(ctx, client, teamID); err != nil {
+			return diag.Errorf("error disabling team logging: %v", err)
+		}
+
+		d.SetId(teamID)
+		return resourceTeamCallbackRead(ctx, d, m)
+	}
+
+	request := buildTeamCallbackRequest(d)
+
+	tflog.Info(ctx, "Adding LiteLLM team callback", map[string]interface{}{
+		"team_id":       teamID,
+		"callback_name": request.CallbackName,
+	})
+
+	if err := addTeamCallback(ctx, client, teamID, request); err != nil {
+		return diag.Errorf("error adding team callback: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", teamID, request.CallbackName))
+
+	return resourceTeamCallbackRead(ctx, d, m)
+}
+
+// resourceTeamCallbackRead reads the logging callbacks of a team from LiteLLM.
+func resourceTeamCallbackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Reading LiteLLM team callback", map[string]interface{}{"id": d.Id()})
+
+	client := m.(*litellm.Client)
+	teamID := d.Get("team_id").(string)
+
+	if d.Get("disable_logging").(bool) {
+		settings, present, err := getTeamCallbackSettings(ctx, client, teamID)
+		if err != nil {
+			return diag.Errorf("error reading team callback: %v", err)
+		}
+		if settings == nil {
+			tflog.Warn(ctx, "Team not found, removing callback from state", map[string]interface{}{"id": d.Id()})
+			d.SetId("")
+			return nil
+		}
+
+		if err := d.Set("disable_logging", loggingDisabled(settings, present)); err != nil {
+			return diag.Errorf("error setting team callback data: %v", err)
+		}
+		return nil
+	}
+
+	data, err := getTeamCallbacks(ctx, client, teamID)
+	if err != nil {
+		return diag.Errorf("error reading team callback: %v", err)
+	}
+
+	if data == nil {
+		tflog.Warn(ctx, "Team not found, removing callback from state", map[string]interface{}{"id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	found, err := setTeamCallbackResourceData(d, data)
+	if err != nil {
+		return diag.Errorf("error setting team callback data: %v", err)
+	}
+
+	if !found {
+		tflog.Warn(ctx, "Team callback not found, removing from state", map[string]interface{}{"id": d.Id()})
+		d.SetId("")
+	}
+
+	return nil
+}
+
+// resourceTeamCallbackUpdate changes the type or variables of a team callback.
+func resourceTeamCallbackUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Updating LiteLLM team callback", map[string]interface{}{"id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	oldVars, _ := d.GetChange("callback_vars")
+	request := buildTeamCallbackRequest(d)
+
+	err := updateTeamCallbackSettings(ctx, client, d.Get("team_id").(string), func(settings *TeamCallbackSettings) *TeamCallbackSettings {
+		return applyCallback(settings, request, expandCallbackVars(oldVars.(map[string]interface{})))
+	})
+	if err != nil {
+		return diag.Errorf("error updating team callback: %v", err)
+	}
+
+	return resourceTeamCallbackRead(ctx, d, m)
+}
+
+// resourceTeamCallbackDelete removes a callback from a team, or re-enables team logging.
+func resourceTeamCallbackDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Deleting LiteLLM team callback", map[string]interface{}{"id": d.Id()})
+
+	client := m.(*litellm.Client)
+
+	disableLogging := d.Get("disable_logging").(bool)
+	callbackName := d.Get("callback_name").(string)
+	vars := expandCallbackVars(d.Get("callback_vars").(map[string]interface{}))
+
+	err := updateTeamCallbackSettings(ctx, client, d.Get("team_id").(string), func(settings *TeamCallbackSettings) *TeamCallbackSettings {
+		if disableLogging {
+			// Dropping the settings restores the proxy-wide callbacks for the team
+			return nil
+		}
+		return removeCallback(settings, callbackName, vars)
+	})
+	if err != nil {
+		return diag.Errorf("error deleting team callback: %v", err)
+	}
+
+	return nil
+}
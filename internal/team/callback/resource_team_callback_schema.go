@@ -0,0 +1,50 @@
+package callback
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTeamCallbackSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"team_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "ID of the team the callback belongs to",
+		},
+		"callback_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"callback_name", "disable_logging"},
+			Description:  "Name of the logging integration, e.g. langfuse, datadog",
+		},
+		"callback_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  callbackTypeSuccessAndFailure,
+			ValidateFunc: validation.StringInSlice([]string{
+				callbackTypeSuccess,
+				callbackTypeFailure,
+				callbackTypeSuccessAndFailure,
+			}, false),
+			Description: "Which requests are logged. Valid values: success, failure, success_and_failure",
+		},
+		"callback_vars": {
+			Type:          schema.TypeMap,
+			Optional:      true,
+			Sensitive:     true,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			ConflictsWith: []string{"disable_logging"},
+			Description:   "Variables passed to the callback, e.g. langfuse_public_key, langfuse_secret_key, langfuse_host",
+		},
+		"disable_logging": {
+			Type:         schema.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"callback_name", "disable_logging"},
+			Description:  "Turn off all logging callbacks for the team instead of adding one",
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TeamModelImporter provides import functionality for LiteLLM team model resources
+func TeamModelImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: teamModelImportState,
+	}
+}
+
+func teamModelImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Expected format: "team_id:model". Model names may contain ":", team IDs do not.
+	teamID, model, err := parseTeamModelID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("model", model)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseTeamModelID splits a "team_id:model" ID into its parts
+func parseTeamModelID(id string) (string, string, error) {
+	teamID, model, ok := strings.Cut(id, ":")
+	if !ok || teamID == "" || model == "" {
+		return "", "", fmt.Errorf("invalid import ID format. Expected 'team_id:model', got: %s", id)
+	}
+
+	return teamID, model, nil
+}
@@ -0,0 +1,56 @@
+package model
+
+import "testing"
+
+func TestParseTeamModelID(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		expectedTeamID string
+		expectedModel  string
+		expectError    bool
+	}{
+		{
+			name:           "simple model",
+			id:             "team-123:gpt-4o",
+			expectedTeamID: "team-123",
+			expectedModel:  "gpt-4o",
+		},
+		{
+			name:           "model containing a colon",
+			id:             "team-123:bedrock/anthropic.claude-3-haiku-20240307-v1:0",
+			expectedTeamID: "team-123",
+			expectedModel:  "bedrock/anthropic.claude-3-haiku-20240307-v1:0",
+		},
+		{
+			name:        "missing model",
+			id:          "team-123",
+			expectError: true,
+		},
+		{
+			name:        "empty team",
+			id:          ":gpt-4o",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamID, model, err := parseTeamModelID(tt.id)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("parseTeamModelID(%q) expected error, got nil", tt.id)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseTeamModelID(%q) unexpected error: %v", tt.id, err)
+			}
+			if teamID != tt.expectedTeamID || model != tt.expectedModel {
+				t.Errorf("parseTeamModelID(%q) = (%q, %q), want (%q, %q)", tt.id, teamID, model, tt.expectedTeamID, tt.expectedModel)
+			}
+		})
+	}
+}
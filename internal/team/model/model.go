@@ -0,0 +1,7 @@
+package model
+
+// TeamModelRequest represents the request payload for /team/model/add and /team/model/delete
+type TeamModelRequest struct {
+	TeamID string   `json:"team_id"`
+	Models []string `json:"models"`
+}
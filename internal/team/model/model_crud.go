@@ -0,0 +1,53 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+	"github.com/scalepad/terraform-provider-litellm/internal/team"
+)
+
+// addTeamModel grants a single model to a team
+func addTeamModel(ctx context.Context, c *litellm.Client, teamID, model string) error {
+	_, err := litellm.SendRequestTypedRateLimited[TeamModelRequest, interface{}](
+		ctx, c, http.MethodPost, "/team/model/add", &TeamModelRequest{TeamID: teamID, Models: []string{model}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add model to team: %w", err)
+	}
+
+	return nil
+}
+
+// hasTeamModel reports whether a model is granted to a team. It returns false when the team does not exist.
+func hasTeamModel(ctx context.Context, c *litellm.Client, teamID, model string) (bool, error) {
+	teamInfo, err := team.GetTeam(ctx, c, teamID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	if teamInfo == nil {
+		return false, nil
+	}
+
+	return slices.Contains(teamInfo.TeamInfo.Models, model), nil
+}
+
+// deleteTeamModel revokes a single model from a team
+func deleteTeamModel(ctx context.Context, c *litellm.Client, teamID, model string) error {
+	_, err := litellm.SendRequestTypedRateLimited[TeamModelRequest, interface{}](
+		ctx, c, http.MethodPost, "/team/model/delete", &TeamModelRequest{TeamID: teamID, Models: []string{model}},
+	)
+	if err != nil {
+		// If it's a not found error, consider it successful (already deleted)
+		if litellm.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete model from team: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ResourceTeamModel defines the schema for granting a single model to a LiteLLM team.
+func ResourceTeamModel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamModelCreate,
+		ReadContext:   resourceTeamModelRead,
+		DeleteContext: resourceTeamModelDelete,
+		Importer:      TeamModelImporter(),
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "ID of the team the model is granted to",
+			},
+			"model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the model granted to the team",
+			},
+		},
+	}
+}
+
+// resourceTeamModelCreate grants a model to a team.
+func resourceTeamModelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+	model := d.Get("model").(string)
+
+	tflog.Info(ctx, "Adding model to LiteLLM team", map[string]interface{}{
+		"team_id": teamID,
+		"model":   model,
+	})
+
+	client := m.(*litellm.Client)
+
+	if err := addTeamModel(ctx, client, teamID, model); err != nil {
+		return diag.Errorf("error adding team model: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", teamID, model))
+
+	return resourceTeamModelRead(ctx, d, m)
+}
+
+// resourceTeamModelRead checks that the model is still granted to the team.
+func resourceTeamModelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+	model := d.Get("model").(string)
+
+	tflog.Info(ctx, "Reading LiteLLM team model", map[string]interface{}{
+		"team_id": teamID,
+		"model":   model,
+	})
+
+	client := m.(*litellm.Client)
+
+	found, err := hasTeamModel(ctx, client, teamID, model)
+	if err != nil {
+		return diag.Errorf("error reading team model: %v", err)
+	}
+
+	if !found {
+		tflog.Warn(ctx, "Team model not found, removing from state", map[string]interface{}{
+			"team_id": teamID,
+			"model":   model,
+		})
+		d.SetId("")
+	}
+
+	return nil
+}
+
+// resourceTeamModelDelete revokes a model from a team.
+func resourceTeamModelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+	model := d.Get("model").(string)
+
+	tflog.Info(ctx, "Removing model from LiteLLM team", map[string]interface{}{
+		"team_id": teamID,
+		"model":   model,
+	})
+
+	client := m.(*litellm.Client)
+
+	if err := deleteTeamModel(ctx, client, teamID, model); err != nil {
+		return diag.Errorf("error deleting team model: %v", err)
+	}
+
+	return nil
+}
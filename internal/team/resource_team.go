@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+	"github.com/scalepad/terraform-provider-litellm/internal/tools/mcp"
 )
 
 // ResourceTeam defines the schema for the LiteLLM team resource.
@@ -20,6 +21,7 @@ func ResourceTeam() *schema.Resource {
 		UpdateContext: resourceTeamUpdate,
 		DeleteContext: resourceTeamDelete,
 		Importer:      TeamImporter(),
+		CustomizeDiff: mcp.ValidateAccessGroupsDiff("object_permission.0.mcp_access_groups"),
 
 		Schema: map[string]*schema.Schema{
 			"team_alias": {
@@ -56,6 +58,11 @@ func ResourceTeam() *schema.Resource {
 				),
 				Description: "Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ('30s'), minutes ('30m'), hours ('30h'), days ('30d').",
 			},
+			"max_parallel_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of parallel requests across all keys of the team",
+			},
 			"models": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -76,11 +83,82 @@ func ResourceTeam() *schema.Resource {
 				Optional:    true,
 				Description: "Budget automatically given to a new team member",
 			},
+			"team_member_key_duration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^(\d+[smhd])$`),
+					"Team member key duration must be in format: number followed by 's' (seconds), 'm' (minutes), 'h' (hours), or 'd' (days). Examples: '30s', '30m', '30h', '30d'",
+				),
+				Description: "Default duration of the keys team members create for this team, e.g. '30d'",
+			},
+			"model_aliases": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of model aliases to model names for the team",
+			},
+			"guardrails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of guardrails applied to the team",
+			},
+			"prompts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of prompts the team is allowed to use",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags for tracking spend and tag-based routing",
+			},
+			"object_permission": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Object-level permissions for the team",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vector_stores": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of vector store IDs the team can access",
+						},
+						"mcp_servers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of MCP server IDs the team can access",
+						},
+						"mcp_access_groups": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of MCP access groups the team can access",
+						},
+					},
+				},
+			},
 			"team_member_budget_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "ID of the team member budget extracted from metadata",
 			},
+			"spend": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Current spend of the team",
+			},
+			"budget_reset_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the team budget will be reset",
+			},
 		},
 	}
 }
@@ -170,6 +248,26 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 	request := buildTeamUpdateRequest(d, d.Id())
 
+	// The metadata is replaced as a whole, so keep the callbacks managed by litellm_team_callback
+	// and the team fields LiteLLM stores in the metadata
+	if request.Metadata != nil {
+		teamResp, err := GetTeam(ctx, client, d.Id())
+		if err != nil {
+			return diag.Errorf("error reading team: %v", err)
+		}
+		if teamResp != nil {
+			for _, k := range append([]string{CallbackSettingsKey}, metadataFields...) {
+				// A field changed in this update is sent with its new value, or cleared
+				if k != CallbackSettingsKey && d.HasChange(k) {
+					continue
+				}
+				if v, ok := teamResp.TeamInfo.Metadata[k]; ok {
+					request.Metadata[k] = v
+				}
+			}
+		}
+	}
+
 	_, err := updateTeam(ctx, client, request)
 	if err != nil {
 		return diag.Errorf("error updating team: %v", err)
@@ -1,6 +1,16 @@
 package team
 
-import "time"
+import (
+	"time"
+
+	"github.com/scalepad/terraform-provider-litellm/internal/utils"
+)
+
+// CallbackSettingsKey is the team metadata key holding the logging callbacks managed by litellm_team_callback
+const CallbackSettingsKey = "callback_settings"
+
+// metadataFields are the team fields LiteLLM stores in the team metadata instead of a column of their own
+var metadataFields = []string{"guardrails", "prompts", "tags", "team_member_key_duration"}
 
 // TeamCreateRequest represents the request payload for creating a new team
 type TeamCreateRequest struct {
@@ -24,19 +34,20 @@ type TeamCreateRequest struct {
 	Tags                  []string               `json:"tags,omitempty"`                    // Tags for tracking spend and/or doing tag-based routing
 
 	// Budget and limits
-	TPMLimit       *int     `json:"tpm_limit,omitempty"`       // The TPM (Tokens Per Minute) limit for this team
-	RPMLimit       *int     `json:"rpm_limit,omitempty"`       // The RPM (Requests Per Minute) limit for this team
-	MaxBudget      *float64 `json:"max_budget,omitempty"`      // The maximum budget allocated to the team
-	BudgetDuration *string  `json:"budget_duration,omitempty"` // The duration of the budget for the team
+	TPMLimit            *int     `json:"tpm_limit,omitempty"`             // The TPM (Tokens Per Minute) limit for this team
+	RPMLimit            *int     `json:"rpm_limit,omitempty"`             // The RPM (Requests Per Minute) limit for this team
+	MaxBudget           *float64 `json:"max_budget,omitempty"`            // The maximum budget allocated to the team
+	BudgetDuration      *string  `json:"budget_duration,omitempty"`       // The duration of the budget for the team
+	MaxParallelRequests *int     `json:"max_parallel_requests,omitempty"` // The maximum number of parallel requests for the team
 
 	// Team member configuration
 	TeamMemberBudget      *float64 `json:"team_member_budget,omitempty"`       // The maximum budget allocated to an individual team member
 	TeamMemberKeyDuration *string  `json:"team_member_key_duration,omitempty"` // The duration for a team member's key
 
 	// Additional configuration
-	Metadata         map[string]interface{} `json:"metadata,omitempty"`          // Metadata for team, store information for team
-	Blocked          bool                   `json:"blocked"`                     // Flag indicating if the team is blocked or not
-	ObjectPermission *ObjectPermissionBase  `json:"object_permission,omitempty"` // team-specific object permission
+	Metadata         map[string]interface{}  `json:"metadata,omitempty"`          // Metadata for team, store information for team
+	Blocked          bool                    `json:"blocked"`                     // Flag indicating if the team is blocked or not
+	ObjectPermission *utils.ObjectPermission `json:"object_permission,omitempty"` // team-specific object permission
 }
 
 // TeamUpdateRequest represents the request payload for updating a team
@@ -49,27 +60,28 @@ type TeamUpdateRequest struct {
 	OrganizationID *string `json:"organization_id,omitempty"` // The organization id of the team
 
 	// Permissions and access control
-	TeamMemberPermissions []string               `json:"team_member_permissions,omitempty"` // A list of routes that non-admin team members can access
-	Models                []string               `json:"models,omitempty"`                  // A list of models associated with the team
-	ModelAliases          map[string]interface{} `json:"model_aliases,omitempty"`           // Model aliases for the team
-	Guardrails            []string               `json:"guardrails,omitempty"`              // Guardrails for the team
-	Prompts               []string               `json:"prompts,omitempty"`                 // List of prompts that the team is allowed to use
-	Tags                  []string               `json:"tags,omitempty"`                    // Tags for tracking spend and/or doing tag-based routing
+	TeamMemberPermissions []string                `json:"team_member_permissions,omitempty"` // A list of routes that non-admin team members can access
+	Models                []string                `json:"models,omitempty"`                  // A list of models associated with the team
+	ModelAliases          *map[string]interface{} `json:"model_aliases,omitempty"`           // Model aliases for the team, an empty map clears them
+	Guardrails            *[]string               `json:"guardrails,omitempty"`              // Guardrails for the team, an empty list clears them
+	Prompts               *[]string               `json:"prompts,omitempty"`                 // List of prompts that the team is allowed to use, an empty list clears them
+	Tags                  *[]string               `json:"tags,omitempty"`                    // Tags for tracking spend and/or doing tag-based routing, an empty list clears them
 
 	// Budget and limits
-	TPMLimit       *int     `json:"tpm_limit,omitempty"`       // The TPM (Tokens Per Minute) limit for this team
-	RPMLimit       *int     `json:"rpm_limit,omitempty"`       // The RPM (Requests Per Minute) limit for this team
-	MaxBudget      *float64 `json:"max_budget,omitempty"`      // The maximum budget allocated to the team
-	BudgetDuration *string  `json:"budget_duration,omitempty"` // The duration of the budget for the team
+	TPMLimit            *int                `json:"tpm_limit,omitempty"`            // The TPM (Tokens Per Minute) limit for this team
+	RPMLimit            *int                `json:"rpm_limit,omitempty"`            // The RPM (Requests Per Minute) limit for this team
+	MaxBudget           *float64            `json:"max_budget,omitempty"`           // The maximum budget allocated to the team
+	BudgetDuration      *string             `json:"budget_duration,omitempty"`      // The duration of the budget for the team
+	MaxParallelRequests utils.Nullable[int] `json:"max_parallel_requests,omitzero"` // The maximum number of parallel requests for the team, null clears it
 
 	// Team member configuration
-	TeamMemberBudget      *float64 `json:"team_member_budget,omitempty"`       // The maximum budget allocated to an individual team member
-	TeamMemberKeyDuration *string  `json:"team_member_key_duration,omitempty"` // The duration for a team member's key
+	TeamMemberBudget      *float64               `json:"team_member_budget,omitempty"`      // The maximum budget allocated to an individual team member
+	TeamMemberKeyDuration utils.Nullable[string] `json:"team_member_key_duration,omitzero"` // The duration for a team member's key, null clears it
 
 	// Additional configuration
-	Metadata         map[string]interface{} `json:"metadata,omitempty"`          // Metadata for team, store information for team
-	Blocked          bool                   `json:"blocked"`                     // Flag indicating if the team is blocked or not
-	ObjectPermission *ObjectPermissionBase  `json:"object_permission,omitempty"` // team-specific object permission
+	Metadata         map[string]interface{}  `json:"metadata,omitempty"`          // Metadata for team, store information for team
+	Blocked          bool                    `json:"blocked"`                     // Flag indicating if the team is blocked or not
+	ObjectPermission *utils.ObjectPermission `json:"object_permission,omitempty"` // team-specific object permission
 }
 
 // TeamCreateResponse represents the response from creating a new team
@@ -87,30 +99,30 @@ type TeamInfoResponse struct {
 
 // TeamInfo represents the detailed information about a team
 type TeamInfo struct {
-	TeamAlias             string                 `json:"team_alias"`
-	TeamID                string                 `json:"team_id"`
-	OrganizationID        *string                `json:"organization_id"`
-	Admins                []string               `json:"admins"`
-	Members               []string               `json:"members"`
-	MembersWithRoles      []MemberWithRole       `json:"members_with_roles"`
-	TeamMemberPermissions []string               `json:"team_member_permissions"`
-	Metadata              map[string]interface{} `json:"metadata"`
-	TPMLimit              *int                   `json:"tpm_limit"`
-	RPMLimit              *int                   `json:"rpm_limit"`
-	MaxBudget             *float64               `json:"max_budget"`
-	BudgetDuration        *string                `json:"budget_duration"`
-	Models                []string               `json:"models"`
-	Blocked               bool                   `json:"blocked"`
-	Spend                 float64                `json:"spend"`
-	MaxParallelRequests   *int                   `json:"max_parallel_requests"`
-	BudgetResetAt         *time.Time             `json:"budget_reset_at"`
-	ModelID               *string                `json:"model_id"`
-	LitellmModelTable     interface{}            `json:"litellm_model_table"`
-	ObjectPermission      *ObjectPermissionBase  `json:"object_permission"`
-	UpdatedAt             time.Time              `json:"updated_at"`
-	CreatedAt             time.Time              `json:"created_at"`
-	ObjectPermissionID    *string                `json:"object_permission_id"`
-	TeamMemberBudgetTable interface{}            `json:"team_member_budget_table"`
+	TeamAlias             string                  `json:"team_alias"`
+	TeamID                string                  `json:"team_id"`
+	OrganizationID        *string                 `json:"organization_id"`
+	Admins                []string                `json:"admins"`
+	Members               []string                `json:"members"`
+	MembersWithRoles      []MemberWithRole        `json:"members_with_roles"`
+	TeamMemberPermissions []string                `json:"team_member_permissions"`
+	Metadata              map[string]interface{}  `json:"metadata"`
+	TPMLimit              *int                    `json:"tpm_limit"`
+	RPMLimit              *int                    `json:"rpm_limit"`
+	MaxBudget             *float64                `json:"max_budget"`
+	BudgetDuration        *string                 `json:"budget_duration"`
+	Models                []string                `json:"models"`
+	Blocked               bool                    `json:"blocked"`
+	Spend                 float64                 `json:"spend"`
+	MaxParallelRequests   *int                    `json:"max_parallel_requests"`
+	BudgetResetAt         *time.Time              `json:"budget_reset_at"`
+	ModelID               *string                 `json:"model_id"`
+	LitellmModelTable     interface{}             `json:"litellm_model_table"`
+	ObjectPermission      *utils.ObjectPermission `json:"object_permission"`
+	UpdatedAt             time.Time               `json:"updated_at"`
+	CreatedAt             time.Time               `json:"created_at"`
+	ObjectPermissionID    *string                 `json:"object_permission_id"`
+	TeamMemberBudgetTable interface{}             `json:"team_member_budget_table"`
 }
 
 // MemberWithRole represents a team member with their role
@@ -141,12 +153,6 @@ type LitellmBudgetTable struct {
 	BudgetDuration      *string     `json:"budget_duration"`
 }
 
-// ObjectPermissionBase represents object-level permissions
-type ObjectPermissionBase struct {
-	VectorStores []string `json:"vector_stores,omitempty"`
-	// Add other object permission fields as needed
-}
-
 // TeamPermissionsResponse represents a response from the API containing team permissions information
 type TeamPermissionsResponse struct {
 	TeamID                  string   `json:"team_id"`
@@ -1,6 +1,10 @@
 package team
 
 import (
+	"encoding/json"
+	"slices"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalepad/terraform-provider-litellm/internal/utils"
 )
@@ -32,6 +36,10 @@ func buildTeamCreateRequest(d *schema.ResourceData) *TeamCreateRequest {
 		limit := v.(int)
 		request.RPMLimit = &limit
 	}
+	if v, ok := d.GetOk("max_parallel_requests"); ok {
+		limit := v.(int)
+		request.MaxParallelRequests = &limit
+	}
 
 	// Float64 fields
 	if v, ok := d.GetOk("max_budget"); ok {
@@ -42,6 +50,10 @@ func buildTeamCreateRequest(d *schema.ResourceData) *TeamCreateRequest {
 		budget := v.(float64)
 		request.TeamMemberBudget = &budget
 	}
+	if v, ok := d.GetOk("team_member_key_duration"); ok {
+		duration := v.(string)
+		request.TeamMemberKeyDuration = &duration
+	}
 
 	// Bool fields
 	if v, ok := d.GetOk("blocked"); ok {
@@ -52,6 +64,9 @@ func buildTeamCreateRequest(d *schema.ResourceData) *TeamCreateRequest {
 	if v, ok := d.GetOk("metadata"); ok {
 		request.Metadata = v.(map[string]interface{})
 	}
+	if v, ok := d.GetOk("model_aliases"); ok {
+		request.ModelAliases = v.(map[string]interface{})
+	}
 
 	// String list fields
 	if v, ok := d.GetOk("models"); ok {
@@ -74,6 +89,21 @@ func buildTeamCreateRequest(d *schema.ResourceData) *TeamCreateRequest {
 		request.TeamMemberPermissions = permissions
 	}
 
+	if v, ok := d.GetOk("guardrails"); ok {
+		request.Guardrails = expandStringList(v)
+	}
+	if v, ok := d.GetOk("prompts"); ok {
+		request.Prompts = expandStringList(v)
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		request.Tags = expandStringList(v)
+	}
+
+	// Nested blocks
+	if v, ok := d.GetOk("object_permission"); ok {
+		request.ObjectPermission = utils.ExpandObjectPermission(v.([]interface{}))
+	}
+
 	return request
 }
 
@@ -117,6 +147,9 @@ func buildTeamUpdateRequest(d *schema.ResourceData, teamID string) *TeamUpdateRe
 			request.RPMLimit = &limit
 		}
 	}
+	if d.HasChange("max_parallel_requests") {
+		request.MaxParallelRequests = utils.GetNullable[int](d, "max_parallel_requests")
+	}
 
 	// Float64 fields - only set if changed
 	if d.HasChange("max_budget") {
@@ -131,6 +164,9 @@ func buildTeamUpdateRequest(d *schema.ResourceData, teamID string) *TeamUpdateRe
 			request.TeamMemberBudget = &budget
 		}
 	}
+	if d.HasChange("team_member_key_duration") {
+		request.TeamMemberKeyDuration = utils.GetNullable[string](d, "team_member_key_duration")
+	}
 
 	// Bool fields - only set if changed
 	if d.HasChange("blocked") {
@@ -170,6 +206,33 @@ func buildTeamUpdateRequest(d *schema.ResourceData, teamID string) *TeamUpdateRe
 		}
 	}
 
+	// Lists and maps LiteLLM can clear - only set if changed, empty values clear them
+	if d.HasChange("model_aliases") {
+		modelAliases := d.Get("model_aliases").(map[string]interface{})
+		request.ModelAliases = &modelAliases
+	}
+	if d.HasChange("guardrails") {
+		guardrails := expandStringList(d.Get("guardrails"))
+		request.Guardrails = &guardrails
+	}
+	if d.HasChange("prompts") {
+		prompts := expandStringList(d.Get("prompts"))
+		request.Prompts = &prompts
+	}
+	if d.HasChange("tags") {
+		tags := expandStringList(d.Get("tags"))
+		request.Tags = &tags
+	}
+
+	// Nested blocks - only include if changed, an empty permission clears it
+	if d.HasChange("object_permission") {
+		permission := utils.ExpandObjectPermission(d.Get("object_permission").([]interface{}))
+		if permission == nil {
+			permission = utils.NewObjectPermission()
+		}
+		request.ObjectPermission = permission
+	}
+
 	return request
 }
 
@@ -197,12 +260,23 @@ func setTeamResourceData(d *schema.ResourceData, teamResp *TeamInfoResponse) err
 	if teamInfo.BudgetDuration != nil {
 		fields["budget_duration"] = *teamInfo.BudgetDuration
 	}
+	if teamInfo.MaxParallelRequests != nil {
+		fields["max_parallel_requests"] = *teamInfo.MaxParallelRequests
+	}
 
 	for field, value := range fields {
 		// Use SetIfNotZero to preserve existing values when API doesn't return them
 		utils.SetIfNotZero(d, field, value)
 	}
 
+	// Computed fields are always set, a team without a budget window has no reset time
+	d.Set("spend", teamInfo.Spend)
+	budgetResetAt := ""
+	if teamInfo.BudgetResetAt != nil {
+		budgetResetAt = teamInfo.BudgetResetAt.Format(time.RFC3339)
+	}
+	d.Set("budget_reset_at", budgetResetAt)
+
 	// Handle metadata separately as it's a map
 	if teamInfo.Metadata != nil {
 		// Create a copy of metadata, extracting team_member_budget_id during iteration
@@ -214,6 +288,10 @@ func setTeamResourceData(d *schema.ResourceData, teamResp *TeamInfoResponse) err
 					d.Set("team_member_budget_id", budgetIDStr)
 				}
 				// Don't include it in the metadata copy
+			} else if k == CallbackSettingsKey {
+				// Managed by litellm_team_callback, don't include it in the metadata copy
+			} else if slices.Contains(metadataFields, k) {
+				// Exposed as top-level fields, don't include them in the metadata copy
 			} else {
 				metadataCopy[k] = v
 			}
@@ -229,5 +307,66 @@ func setTeamResourceData(d *schema.ResourceData, teamResp *TeamInfoResponse) err
 		d.Set("models", d.Get("models"))
 	}
 
+	// Fields LiteLLM stores in the metadata, a missing key means the field is not set
+	for _, field := range []string{"guardrails", "prompts", "tags"} {
+		if err := d.Set(field, metadataStringList(teamInfo.Metadata, field)); err != nil {
+			return err
+		}
+	}
+	duration, _ := teamInfo.Metadata["team_member_key_duration"].(string)
+	if err := d.Set("team_member_key_duration", duration); err != nil {
+		return err
+	}
+
+	// Model aliases are stored in the model table linked to the team
+	if err := d.Set("model_aliases", flattenModelAliases(teamInfo.LitellmModelTable)); err != nil {
+		return err
+	}
+
+	if err := d.Set("object_permission", utils.FlattenObjectPermission(teamInfo.ObjectPermission)); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// expandStringList converts a Terraform list value into a string slice
+func expandStringList(v interface{}) []string {
+	list, _ := v.([]interface{})
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+// metadataStringList returns a string list stored in the team metadata
+func metadataStringList(metadata map[string]interface{}, key string) []string {
+	return expandStringList(metadata[key])
+}
+
+// flattenModelAliases returns the model aliases of the model table linked to a team
+func flattenModelAliases(modelTable interface{}) map[string]interface{} {
+	table, _ := modelTable.(map[string]interface{})
+
+	// Older LiteLLM versions return the aliases as a JSON string
+	var aliases map[string]interface{}
+	switch v := table["model_aliases"].(type) {
+	case map[string]interface{}:
+		aliases = v
+	case string:
+		_ = json.Unmarshal([]byte(v), &aliases)
+	}
+
+	result := make(map[string]interface{}, len(aliases))
+	for k, v := range aliases {
+		if s, ok := v.(string); ok {
+			result[k] = s
+		}
+	}
+
+	return result
+}
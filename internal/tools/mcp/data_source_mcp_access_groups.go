@@ -0,0 +1,65 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+func DataSourceLiteLLMMCPAccessGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLiteLLMMCPAccessGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Access group names to look up. Reading the data source fails if any of them does not exist. If not set, all access groups are returned.",
+			},
+			"access_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the returned MCP access groups",
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMMCPAccessGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*litellm.Client)
+
+	groups, err := ListAccessGroups(ctx, c)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read MCP access groups: %w", err))
+	}
+
+	names := make([]string, 0)
+	for _, v := range d.Get("names").([]interface{}) {
+		if s, ok := v.(string); ok {
+			names = append(names, s)
+		}
+	}
+
+	if len(names) > 0 {
+		if missing := missingAccessGroups(groups, names); len(missing) > 0 {
+			return diag.Errorf("MCP access groups not found: %s", strings.Join(missing, ", "))
+		}
+		groups = names
+	} else {
+		sort.Strings(groups)
+	}
+
+	d.SetId("mcp_access_groups")
+
+	if err := d.Set("access_groups", groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
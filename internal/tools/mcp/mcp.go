@@ -72,3 +72,8 @@ type MCPServerResponse struct {
 	Args             []string            `json:"args,omitempty"`
 	Env              map[string]string   `json:"env,omitempty"`
 }
+
+// MCPAccessGroupsResponse represents the response of GET /v1/mcp/access_groups
+type MCPAccessGroupsResponse struct {
+	AccessGroups []string `json:"access_groups"`
+}
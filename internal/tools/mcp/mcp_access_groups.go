@@ -0,0 +1,68 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
+)
+
+// ValidateAccessGroupsDiff returns a CustomizeDiff function that fails the plan when the MCP access
+// groups at path do not exist on the proxy. The check is skipped while any of the groups is still
+// unknown, for example when it references an attribute of a resource that is not created yet.
+func ValidateAccessGroupsDiff(path string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown(path) {
+			return nil
+		}
+
+		values := d.Get(path).([]interface{})
+		groups := make([]string, 0, len(values))
+		for i, v := range values {
+			if !d.NewValueKnown(fmt.Sprintf("%s.%d", path, i)) {
+				return nil
+			}
+			if s, ok := v.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+		if len(groups) == 0 {
+			return nil
+		}
+
+		c, ok := m.(*litellm.Client)
+		if !ok || c == nil {
+			return nil
+		}
+
+		known, err := ListAccessGroups(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		if missing := missingAccessGroups(known, groups); len(missing) > 0 {
+			return fmt.Errorf("%s: MCP access groups not found: %s. Access groups are created by assigning them to a litellm_mcp_server; "+
+				"if the group is introduced in this configuration, apply that server first", path, strings.Join(missing, ", "))
+		}
+
+		return nil
+	}
+}
+
+// missingAccessGroups returns the groups that are not in known, in the order given
+func missingAccessGroups(known, groups []string) []string {
+	exists := make(map[string]bool, len(known))
+	for _, group := range known {
+		exists[group] = true
+	}
+
+	var missing []string
+	for _, group := range groups {
+		if !exists[group] {
+			missing = append(missing, group)
+		}
+	}
+	return missing
+}
@@ -55,3 +55,15 @@ func deleteMCPServer(ctx context.Context, c *litellm.Client, serverID string) er
 	_, err := c.SendRequest(ctx, http.MethodDelete, fmt.Sprintf("/v1/mcp/server/%s", serverID), nil)
 	return err
 }
+
+// ListAccessGroups retrieves the MCP access groups defined on the proxy
+func ListAccessGroups(ctx context.Context, c *litellm.Client) ([]string, error) {
+	response, err := litellm.SendRequestTyped[interface{}, MCPAccessGroupsResponse](
+		ctx, c, http.MethodGet, "/v1/mcp/access_groups", nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MCP access groups: %w", err)
+	}
+
+	return response.AccessGroups, nil
+}
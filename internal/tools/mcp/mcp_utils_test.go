@@ -1,13 +1,21 @@
 package mcp
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scalepad/terraform-provider-litellm/internal/litellm"
 	"github.com/scalepad/terraform-provider-litellm/internal/utils"
 )
 
+// unknownValue is how the SDK represents a value that is not known until apply
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
 func TestBuildMCPServerData(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -600,3 +608,124 @@ func TestBuildMCPServerRequestFromStruct(t *testing.T) {
 		})
 	}
 }
+
+func TestMissingAccessGroups(t *testing.T) {
+	tests := []struct {
+		name     string
+		known    []string
+		groups   []string
+		expected []string
+	}{
+		{
+			name:     "all groups exist",
+			known:    []string{"internal-tools", "public-tools"},
+			groups:   []string{"public-tools"},
+			expected: nil,
+		},
+		{
+			name:     "typo in a group name",
+			known:    []string{"internal-tools", "public-tools"},
+			groups:   []string{"internal-tool", "public-tools", "search"},
+			expected: []string{"internal-tool", "search"},
+		},
+		{
+			name:     "no groups defined on the proxy",
+			known:    nil,
+			groups:   []string{"internal-tools"},
+			expected: []string{"internal-tools"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := missingAccessGroups(tt.known, tt.groups); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("missingAccessGroups() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateAccessGroupsDiff(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/mcp/access_groups" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_groups": ["internal-tools", "public-tools"]}`))
+	}))
+	defer server.Close()
+
+	client := litellm.NewClient(server.URL, "sk-test", false)
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"mcp_access_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: ValidateAccessGroupsDiff("mcp_access_groups"),
+	}
+
+	tests := []struct {
+		name          string
+		groups        interface{}
+		expectError   bool
+		expectRequest bool
+	}{
+		{
+			name:          "all groups exist",
+			groups:        []interface{}{"internal-tools"},
+			expectError:   false,
+			expectRequest: true,
+		},
+		{
+			name:          "missing group",
+			groups:        []interface{}{"internal-tool", "public-tools"},
+			expectError:   true,
+			expectRequest: true,
+		},
+		{
+			name:          "unknown group",
+			groups:        []interface{}{"internal-tool", unknownValue},
+			expectError:   false,
+			expectRequest: false,
+		},
+		{
+			name:          "unknown list",
+			groups:        unknownValue,
+			expectError:   false,
+			expectRequest: false,
+		},
+		{
+			name:          "no groups",
+			groups:        []interface{}{},
+			expectError:   false,
+			expectRequest: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+
+			_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"mcp_access_groups": tt.groups,
+			}), client)
+
+			if tt.expectError && err == nil {
+				t.Errorf("Expected an error for missing MCP access groups")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Diff() unexpected error: %v", err)
+			}
+			if got := requests > 0; got != tt.expectRequest {
+				t.Errorf("Expected access groups request = %t, got %t", tt.expectRequest, got)
+			}
+		})
+	}
+}
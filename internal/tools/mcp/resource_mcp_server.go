@@ -219,7 +219,7 @@ func resourceLiteLLMMCPServerRead(ctx context.Context, d *schema.ResourceData, m
 	serverResp, err := getMCPServer(ctx, client, serverID)
 	if err != nil {
 		// Handle not found case
-		if err.Error() == "mcp_server_not_found" {
+		if litellm.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -133,6 +133,9 @@ func buildUserCreateRequest(d *schema.ResourceData) *UserCreateRequest {
 	if v, ok := d.GetOk("budget_duration"); ok {
 		req.BudgetDuration = v.(string)
 	}
+	if v, ok := d.GetOk("budget_id"); ok {
+		req.BudgetID = v.(string)
+	}
 	if v, ok := d.GetOk("duration"); ok {
 		req.Duration = v.(string)
 	}
@@ -246,6 +249,9 @@ func buildUserUpdateRequest(d *schema.ResourceData, userID string) *UserUpdateRe
 	if v, ok := d.GetOk("budget_duration"); ok {
 		req.BudgetDuration = v.(string)
 	}
+	if v, ok := d.GetOk("budget_id"); ok {
+		req.BudgetID = v.(string)
+	}
 	if v, ok := d.GetOk("sso_user_id"); ok {
 		req.SSOUserID = v.(string)
 	}
@@ -342,6 +348,7 @@ func setUserResourceData(d *schema.ResourceData, user *User) error {
 	utils.SetIfNotZero(d, "user_alias", user.UserAlias)
 	utils.SetIfNotZero(d, "user_role", user.UserRole)
 	utils.SetIfNotZero(d, "budget_duration", user.BudgetDuration)
+	utils.SetIfNotZero(d, "budget_id", user.BudgetID)
 	utils.SetIfNotZero(d, "duration", user.Duration)
 	utils.SetIfNotZero(d, "key_alias", user.KeyAlias)
 	utils.SetIfNotZero(d, "sso_user_id", user.SSOUserID)
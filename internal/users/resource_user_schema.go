@@ -46,6 +46,11 @@ func resourceUserSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Duration for the budget (e.g., '30s', '30m', '30h', '30d', '1mo')",
 		},
+		"budget_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of an existing budget to attach to the user",
+		},
 		"models": {
 			Type:        schema.TypeList,
 			Optional:    true,
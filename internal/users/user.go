@@ -8,6 +8,7 @@ type UserInfo struct {
 	UserRole             string                 `json:"user_role,omitempty"`
 	MaxBudget            float64                `json:"max_budget,omitempty"`
 	BudgetDuration       string                 `json:"budget_duration,omitempty"`
+	BudgetID             string                 `json:"budget_id,omitempty"`
 	Models               []string               `json:"models,omitempty"`
 	TPMLimit             int                    `json:"tpm_limit,omitempty"`
 	RPMLimit             int                    `json:"rpm_limit,omitempty"`
@@ -34,6 +35,7 @@ type User struct {
 	UserRole             string                 `json:"user_role,omitempty"`
 	MaxBudget            float64                `json:"max_budget,omitempty"`
 	BudgetDuration       string                 `json:"budget_duration,omitempty"`
+	BudgetID             string                 `json:"budget_id,omitempty"`
 	Models               []string               `json:"models,omitempty"`
 	TPMLimit             int                    `json:"tpm_limit,omitempty"`
 	RPMLimit             int                    `json:"rpm_limit,omitempty"`
@@ -69,6 +71,7 @@ type UserCreateRequest struct {
 	UserRole             string                 `json:"user_role,omitempty"`
 	MaxBudget            float64                `json:"max_budget,omitempty"`
 	BudgetDuration       string                 `json:"budget_duration,omitempty"`
+	BudgetID             string                 `json:"budget_id,omitempty"`
 	Models               []string               `json:"models,omitempty"`
 	TPMLimit             int                    `json:"tpm_limit,omitempty"`
 	RPMLimit             int                    `json:"rpm_limit,omitempty"`
@@ -99,6 +102,7 @@ type UserUpdateRequest struct {
 	UserRole             string                 `json:"user_role,omitempty"`
 	MaxBudget            float64                `json:"max_budget,omitempty"`
 	BudgetDuration       string                 `json:"budget_duration,omitempty"`
+	BudgetID             string                 `json:"budget_id,omitempty"`
 	Models               []string               `json:"models,omitempty"`
 	TPMLimit             int                    `json:"tpm_limit,omitempty"`
 	RPMLimit             int                    `json:"rpm_limit,omitempty"`
@@ -23,6 +23,10 @@ func GetUser(ctx context.Context, client *litellm.Client, userID string) (*User,
 	path := fmt.Sprintf("/user/info?user_id=%s", url.QueryEscape(userID))
 	userResponse, err := litellm.SendRequestTyped[any, UserResponse](ctx, client, http.MethodGet, path, nil)
 	if err != nil {
+		// Check if it's a not found error
+		if litellm.IsNotFound(err) {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
@@ -34,6 +38,7 @@ func GetUser(ctx context.Context, client *litellm.Client, userID string) (*User,
 		UserRole:             userResponse.UserInfo.UserRole,
 		MaxBudget:            userResponse.UserInfo.MaxBudget,
 		BudgetDuration:       userResponse.UserInfo.BudgetDuration,
+		BudgetID:             userResponse.UserInfo.BudgetID,
 		Models:               userResponse.UserInfo.Models,
 		TPMLimit:             userResponse.UserInfo.TPMLimit,
 		RPMLimit:             userResponse.UserInfo.RPMLimit,
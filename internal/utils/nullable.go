@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Nullable is an optional request field that can also be cleared.
+// Use it with the `omitzero` JSON tag: an unset field is omitted from the request body,
+// a null field is sent as an explicit JSON null so the API clears the stored value.
+type Nullable[T any] struct {
+	value *T
+	set   bool
+}
+
+// NullableValue returns a Nullable holding v
+func NullableValue[T any](v T) Nullable[T] {
+	return Nullable[T]{value: &v, set: true}
+}
+
+// NullValue returns a Nullable that is sent as JSON null
+func NullValue[T any]() Nullable[T] {
+	return Nullable[T]{set: true}
+}
+
+// IsZero reports whether the field is unset, so `omitzero` leaves it out of the request body
+func (n Nullable[T]) IsZero() bool {
+	return !n.set
+}
+
+// IsNull reports whether the field is sent as JSON null
+func (n Nullable[T]) IsNull() bool {
+	return n.set && n.value == nil
+}
+
+// Get returns the value and whether one is present
+func (n Nullable[T]) Get() (T, bool) {
+	if n.value == nil {
+		var zero T
+		return zero, false
+	}
+	return *n.value, true
+}
+
+// MarshalJSON encodes the value, or null when the field is cleared
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.value == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(*n.value)
+}
+
+// UnmarshalJSON decodes a value or an explicit null
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	n.set = true
+	n.value = nil
+	if string(data) == "null" {
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.value = &v
+	return nil
+}
+
+// GetNullable reads an optional attribute for a request that may clear it.
+// A configured value is returned as is. An attribute that was removed from the configuration
+// is returned as null so the API clears it, and an attribute that was never set is left unset.
+func GetNullable[T any](d *schema.ResourceData, key string) Nullable[T] {
+	if v, ok := d.GetOk(key); ok {
+		return NullableValue(v.(T))
+	}
+	if d.HasChange(key) {
+		return NullValue[T]()
+	}
+	return Nullable[T]{}
+}
@@ -0,0 +1,62 @@
+package utils
+
+// ObjectPermission represents the object-level permissions of a key, team or organization.
+// The lists are always sent, as LiteLLM only updates the fields present in the request:
+// an empty list clears the existing permissions on update.
+type ObjectPermission struct {
+	ObjectPermissionID string   `json:"object_permission_id,omitempty"`
+	VectorStores       []string `json:"vector_stores"`
+	MCPServers         []string `json:"mcp_servers"`
+	MCPAccessGroups    []string `json:"mcp_access_groups"`
+}
+
+// NewObjectPermission returns a permission with empty lists, which clears all object permissions on update
+func NewObjectPermission() *ObjectPermission {
+	return &ObjectPermission{
+		VectorStores:    []string{},
+		MCPServers:      []string{},
+		MCPAccessGroups: []string{},
+	}
+}
+
+// ExpandObjectPermission converts the object_permission block into the API representation.
+// It returns nil when the block is not set.
+func ExpandObjectPermission(list []interface{}) *ObjectPermission {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	m := list[0].(map[string]interface{})
+	permission := NewObjectPermission()
+
+	if v, ok := m["vector_stores"].([]interface{}); ok {
+		permission.VectorStores = expandStringList(v)
+	}
+	if v, ok := m["mcp_servers"].([]interface{}); ok {
+		permission.MCPServers = expandStringList(v)
+	}
+	if v, ok := m["mcp_access_groups"].([]interface{}); ok {
+		permission.MCPAccessGroups = expandStringList(v)
+	}
+
+	return permission
+}
+
+// FlattenObjectPermission converts the API object permission into the Terraform block representation.
+// It returns nil when there are no permissions, so that an unset block does not produce a diff.
+func FlattenObjectPermission(permission *ObjectPermission) []interface{} {
+	if permission == nil {
+		return nil
+	}
+	if len(permission.VectorStores) == 0 && len(permission.MCPServers) == 0 && len(permission.MCPAccessGroups) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vector_stores":     permission.VectorStores,
+			"mcp_servers":       permission.MCPServers,
+			"mcp_access_groups": permission.MCPAccessGroups,
+		},
+	}
+}
@@ -0,0 +1,32 @@
+// Package testutil provides helpers for the unit tests of the resource packages.
+// It is only imported from _test.go files, so it is not linked into the provider.
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// ResourceDataWithState returns resource data for an update from the prior state to the new configuration,
+// so that HasChange reports the attributes that differ between the two
+func ResourceDataWithState(t *testing.T, r *schema.Resource, state, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	prior := schema.TestResourceDataRaw(t, r.Schema, state)
+	prior.SetId("test-id")
+
+	diff, err := r.Diff(context.Background(), prior.State(), terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("failed to diff resource: %v", err)
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(prior.State(), diff)
+	if err != nil {
+		t.Fatalf("failed to build resource data: %v", err)
+	}
+
+	return d
+}
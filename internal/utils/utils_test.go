@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -221,3 +222,34 @@ func getSchemaType(value interface{}) schema.ValueType {
 		return schema.TypeString
 	}
 }
+
+func TestNullableMarshal(t *testing.T) {
+	type request struct {
+		ID       string           `json:"id"`
+		Limit    Nullable[int]    `json:"limit,omitzero"`
+		Duration Nullable[string] `json:"duration,omitzero"`
+	}
+
+	tests := []struct {
+		name     string
+		request  request
+		expected string
+	}{
+		{name: "unset fields are omitted", request: request{ID: "a"}, expected: `{"id":"a"}`},
+		{name: "values are sent", request: request{ID: "a", Limit: NullableValue(10), Duration: NullableValue("30d")}, expected: `{"id":"a","limit":10,"duration":"30d"}`},
+		{name: "zero values are sent", request: request{ID: "a", Limit: NullableValue(0)}, expected: `{"id":"a","limit":0}`},
+		{name: "null fields are sent as null", request: request{ID: "a", Limit: NullValue[int]()}, expected: `{"id":"a","limit":null}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.request)
+			if err != nil {
+				t.Fatalf("json.Marshal() unexpected error: %v", err)
+			}
+			if string(body) != tt.expected {
+				t.Errorf("json.Marshal() = %s, want %s", body, tt.expected)
+			}
+		})
+	}
+}